@@ -4,12 +4,18 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"net"
+	"slices"
+	"sync"
 	"time"
 
 	pbApic "github.com/protosio/protos/apic/proto"
+	"github.com/protosio/protos/internal/p2p"
 	p2pproto "github.com/protosio/protos/internal/p2p/proto"
 	"github.com/protosio/protos/internal/pcrypto"
+	"github.com/protosio/protos/internal/protosc"
 	"github.com/protosio/protos/internal/release"
+	"github.com/protosio/protos/internal/util"
 )
 
 //
@@ -37,6 +43,7 @@ func (b *Backend) GetUserDevices(ctx context.Context, in *pbApic.GetUserDevicesR
 	}
 
 	resp := pbApic.GetUserDevicesResponse{}
+	approvedKeys := b.protosClient.ApprovedDeviceKeys(adminUser.Devices)
 
 	for _, device := range adminUser.Devices {
 		wgPubKey := "n/a"
@@ -53,6 +60,8 @@ func (b *Backend) GetUserDevices(ctx context.Context, in *pbApic.GetUserDevicesR
 			Network:            device.Network,
 			PublicKey:          device.PublicKey,
 			PublicKeyWireguard: wgPubKey,
+			Hub:                device.Hub,
+			Approved:           slices.Contains(approvedKeys, device.PublicKey),
 		}
 		resp.Devices = append(resp.Devices, &respDevice)
 	}
@@ -60,6 +69,45 @@ func (b *Backend) GetUserDevices(ctx context.Context, in *pbApic.GetUserDevicesR
 	return &resp, nil
 }
 
+func (b *Backend) AddExternalDevice(ctx context.Context, in *pbApic.AddExternalDeviceRequest) (*pbApic.AddExternalDeviceResponse, error) {
+	log.Debugf("Adding external device '%s' using hub '%s'", in.Name, in.Hub)
+	if in.Name == "" || in.Hub == "" {
+		return nil, fmt.Errorf("device name and hub instance are required")
+	}
+
+	device, wgConfig, err := b.protosClient.AddExternalDevice(in.Name, in.Hub)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add external device: %w", err)
+	}
+
+	wgPublicKey, err := pcrypto.ConvertPublicEd25519ToCurve25519(device.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add external device: %w", err)
+	}
+
+	resp := pbApic.AddExternalDeviceResponse{
+		Device: &pbApic.UserDevice{
+			Name:               device.Name,
+			MachineId:          device.MachineID,
+			Network:            device.Network,
+			PublicKey:          device.PublicKey,
+			PublicKeyWireguard: wgPublicKey.String(),
+			Hub:                device.Hub,
+		},
+		WireguardConfig: wgConfig,
+	}
+	return &resp, nil
+}
+
+func (b *Backend) ApproveDevice(ctx context.Context, in *pbApic.ApproveDeviceRequest) (*pbApic.ApproveDeviceResponse, error) {
+	log.Debugf("Approving device '%s'", in.Name)
+	err := b.protosClient.ApproveDevice(in.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to approve device: %w", err)
+	}
+	return &pbApic.ApproveDeviceResponse{}, nil
+}
+
 func (b *Backend) GetUserInfo(ctx context.Context, in *pbApic.GetUserInfoRequest) (*pbApic.GetUserInfoResponse, error) {
 	log.Debugf("Retrieving user info")
 	adminUser, err := b.protosClient.UserManager.GetAdmin()
@@ -101,8 +149,14 @@ func (b *Backend) GetApps(ctx context.Context, in *pbApic.GetAppsRequest) (*pbAp
 			if err != nil {
 				log.Errorf("Failed to retrieve status for app '%s': %s", app.Name, err.Error())
 				status = "n/a"
+			} else {
+				status = resp.Status
 			}
-			status = resp.Status
+		}
+
+		ports := []string{}
+		for _, port := range app.Ports {
+			ports = append(ports, port.String())
 		}
 
 		respApp := pbApic.App{
@@ -113,6 +167,9 @@ func (b *Backend) GetApps(ctx context.Context, in *pbApic.GetAppsRequest) (*pbAp
 			InstanceName: app.InstanceName,
 			Ip:           app.IP.String(),
 			Installer:    app.InstallerRef,
+			Persistence:  app.Persistence,
+			Ports:        ports,
+			Capabilities: app.Capabilities,
 		}
 		resp.Apps = append(resp.Apps, &respApp)
 	}
@@ -128,8 +185,17 @@ func (b *Backend) CreateApp(ctx context.Context, in *pbApic.CreateAppRequest) (*
 		return nil, fmt.Errorf("failed to run app %s: %w", in.Name, err)
 	}
 
+	ports := []util.Port{}
+	for _, portStr := range in.Ports {
+		port, err := util.ParsePort(portStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to run app %s: %w", in.Name, err)
+		}
+		ports = append(ports, port)
+	}
+
 	// FIXME: read the installer params from the command line
-	app, err := b.protosClient.AppManager.Create(in.InstallerId, in.Name, in.InstanceId, instance.Network, in.Persistence, map[string]string{})
+	app, err := b.protosClient.AppManager.Create(in.InstallerId, in.Name, in.InstanceId, instance.Network, in.Persistence, ports, in.Capabilities, map[string]string{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to run app %s: %w", in.Name, err)
 	}
@@ -193,6 +259,96 @@ func (b *Backend) GetAppLogs(ctx context.Context, in *pbApic.GetAppLogsRequest)
 	return &pbApic.GetAppLogsResponse{Logs: []byte(base64Logs)}, nil
 }
 
+func (b *Backend) ListAppFiles(ctx context.Context, in *pbApic.ListAppFilesRequest) (*pbApic.ListAppFilesResponse, error) {
+	log.Debugf("Listing files '%s' for app '%s'", in.Path, in.Name)
+
+	app, err := b.protosClient.AppManager.Get(in.Name)
+	if err != nil {
+		return nil, fmt.Errorf("could not list files for app '%s': %w", in.Name, err)
+	}
+
+	files, err := b.protosClient.P2PManager.ListFiles(ctx, app.InstanceName, app.Name, in.Path)
+	if err != nil {
+		return nil, fmt.Errorf("could not list files for app '%s': %w", in.Name, err)
+	}
+
+	resp := pbApic.ListAppFilesResponse{}
+	for _, file := range files {
+		resp.Files = append(resp.Files, &pbApic.AppFile{Path: file.Path, Size: file.Size, Mode: file.Mode, ModTime: file.ModTime, IsDir: file.IsDir})
+	}
+	return &resp, nil
+}
+
+func (b *Backend) PullAppFiles(ctx context.Context, in *pbApic.PullAppFilesRequest) (*pbApic.PullAppFilesResponse, error) {
+	log.Debugf("Pulling '%s' from app '%s' to '%s'", in.Path, in.Name, in.LocalPath)
+
+	app, err := b.protosClient.AppManager.Get(in.Name)
+	if err != nil {
+		return nil, fmt.Errorf("could not pull files from app '%s': %w", in.Name, err)
+	}
+
+	stats, err := b.protosClient.P2PManager.PullFiles(ctx, app.InstanceName, app.Name, in.Path, in.LocalPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not pull files from app '%s': %w", in.Name, err)
+	}
+	return &pbApic.PullAppFilesResponse{Files: int32(stats.Files), Bytes: stats.Bytes}, nil
+}
+
+func (b *Backend) PushAppFiles(ctx context.Context, in *pbApic.PushAppFilesRequest) (*pbApic.PushAppFilesResponse, error) {
+	log.Debugf("Pushing '%s' to '%s' in app '%s'", in.LocalPath, in.Path, in.Name)
+
+	app, err := b.protosClient.AppManager.Get(in.Name)
+	if err != nil {
+		return nil, fmt.Errorf("could not push files to app '%s': %w", in.Name, err)
+	}
+
+	stats, err := b.protosClient.P2PManager.PushFiles(ctx, app.InstanceName, app.Name, in.LocalPath, in.Path)
+	if err != nil {
+		return nil, fmt.Errorf("could not push files to app '%s': %w", in.Name, err)
+	}
+	return &pbApic.PushAppFilesResponse{Files: int32(stats.Files), Bytes: stats.Bytes}, nil
+}
+
+// PortForward forwards the connections made to a local address to a port of an app, until the call is cancelled
+func (b *Backend) PortForward(in *pbApic.PortForwardRequest, stream pbApic.ProtosClientApi_PortForwardServer) error {
+	log.Debugf("Forwarding '%s' to port %d of app '%s'", in.LocalAddress, in.RemotePort, in.Name)
+
+	app, err := b.protosClient.AppManager.Get(in.Name)
+	if err != nil {
+		return fmt.Errorf("could not forward port of app '%s': %w", in.Name, err)
+	}
+
+	listener, err := net.Listen("tcp", in.LocalAddress)
+	if err != nil {
+		return fmt.Errorf("could not listen on '%s': %w", in.LocalAddress, err)
+	}
+	err = stream.Send(&pbApic.PortForwardEvent{State: "listening", Address: listener.Addr().String()})
+	if err != nil {
+		listener.Close()
+		return fmt.Errorf("failed to send port forward event: %w", err)
+	}
+
+	// connections are handled concurrently, so the events are sent one at a time
+	var sendMu sync.Mutex
+	notify := func(event p2p.PortForwardEvent) {
+		resp := &pbApic.PortForwardEvent{State: "opened", Address: event.Client}
+		if event.Closed {
+			resp.State = "closed"
+		}
+		if event.Err != nil {
+			resp.State = "failed"
+			resp.Error = event.Err.Error()
+		}
+		sendMu.Lock()
+		defer sendMu.Unlock()
+		if err := stream.Send(resp); err != nil {
+			log.Debugf("Failed to send port forward event: %s", err.Error())
+		}
+	}
+
+	return b.protosClient.P2PManager.ForwardPort(stream.Context(), listener, app.InstanceName, app.Name, int(in.RemotePort), notify)
+}
+
 //
 // Cloud provider methods
 //
@@ -357,6 +513,9 @@ func (b *Backend) GetInstances(ctx context.Context, in *pbApic.GetInstancesReque
 			Architecture:       instance.Architecture,
 			Status:             instance.Status,
 		}
+		if err := b.protosClient.P2PManager.CheckPeerCompatibility(instance.Name, instance.ProtosVersion); err != nil {
+			respInstance.UpgradeNeeded = err.Error()
+		}
 		resp.Instances = append(resp.Instances, &respInstance)
 	}
 
@@ -376,7 +535,7 @@ func (b *Backend) GetInstance(ctx context.Context, in *pbApic.GetInstanceRequest
 	}
 
 	var status string
-	peers := map[string]string{}
+	peers := []*pbApic.PeerState{}
 	client, err := b.protosClient.P2PManager.GetClient(instance.Name)
 	if err != nil {
 		log.Error(err.Error())
@@ -389,8 +548,23 @@ func (b *Backend) GetInstance(ctx context.Context, in *pbApic.GetInstanceRequest
 		} else {
 			status = fmt.Sprintf("%s (%s)", instance.Status, "reachable")
 		}
-		for name, peer := range resp.Peers {
-			peers[name] = peer
+		for _, peer := range resp.GetPeerStates() {
+			// aliases are temporary ids of peers that rotate their key
+			if peer.Alias {
+				continue
+			}
+			peers = append(peers, &pbApic.PeerState{
+				Id:                peer.Id,
+				Name:              peer.Name,
+				Type:              peer.Type,
+				State:             peer.State,
+				ConnectedSince:    peer.ConnectedSince,
+				ReconnectAttempts: peer.ReconnectAttempts,
+				NextAttempt:       peer.NextAttempt,
+				LastError:         peer.LastError,
+				Disconnects:       peer.Disconnects,
+				Rtt:               peer.Rtt,
+			})
 		}
 	}
 
@@ -409,15 +583,22 @@ func (b *Backend) GetInstance(ctx context.Context, in *pbApic.GetInstanceRequest
 			ProtosVersion:      instance.ProtosVersion,
 			Status:             status,
 			Architecture:       instance.Architecture,
-			Peers:              peers,
+			Multiaddrs:         instance.Multiaddrs,
+			PeerStates:         peers,
 		},
 	}
+	if err := b.protosClient.P2PManager.CheckPeerCompatibility(instance.Name, instance.ProtosVersion); err != nil {
+		resp.Instance.UpgradeNeeded = err.Error()
+	}
 
 	return &resp, nil
 }
 
 func (b *Backend) DeployInstance(ctx context.Context, in *pbApic.DeployInstanceRequest) (*pbApic.DeployInstanceResponse, error) {
 	log.Debugf("Deploying new instance '%s'", in.Name)
+	if err := b.checkNotStaged(); err != nil {
+		return nil, fmt.Errorf("failed to deploy instance '%s': %w", in.Name, err)
+	}
 
 	releases, err := b.protosClient.GetProtosAvailableReleases()
 	if err != nil {
@@ -470,6 +651,9 @@ func (b *Backend) DeployInstance(ctx context.Context, in *pbApic.DeployInstanceR
 
 func (b *Backend) RemoveInstance(ctx context.Context, in *pbApic.RemoveInstanceRequest) (*pbApic.RemoveInstanceResponse, error) {
 	log.Debugf("Removing instance '%s'", in.Name)
+	if err := b.checkNotStaged(); err != nil {
+		return nil, fmt.Errorf("failed to remove instance '%s': %w", in.Name, err)
+	}
 	err := b.protosClient.CloudManager.DeleteInstance(in.Name)
 	if err != nil {
 		return nil, fmt.Errorf("failed to remove instance '%s': %w", in.Name, err)
@@ -480,15 +664,27 @@ func (b *Backend) RemoveInstance(ctx context.Context, in *pbApic.RemoveInstanceR
 
 func (b *Backend) StartInstance(ctx context.Context, in *pbApic.StartInstanceRequest) (*pbApic.StartInstanceResponse, error) {
 	log.Debugf("Starting instance '%s'", in.Name)
+	if err := b.checkNotStaged(); err != nil {
+		return nil, fmt.Errorf("failed to start instance '%s': %w", in.Name, err)
+	}
 	err := b.protosClient.CloudManager.StartInstance(in.Name)
 	if err != nil {
 		return nil, fmt.Errorf("failed to start instance '%s': %w", in.Name, err)
 	}
+
+	// the public IP of the instance can change after a start, which affects the peers and the public DNS records
+	err = b.protosClient.Refresh()
+	if err != nil {
+		log.Errorf("Failed to refresh after starting instance '%s': %s", in.Name, err.Error())
+	}
 	return &pbApic.StartInstanceResponse{}, nil
 }
 
 func (b *Backend) StopInstance(ctx context.Context, in *pbApic.StopInstanceRequest) (*pbApic.StopInstanceResponse, error) {
 	log.Debugf("Stopping instance '%s'", in.Name)
+	if err := b.checkNotStaged(); err != nil {
+		return nil, fmt.Errorf("failed to stop instance '%s': %w", in.Name, err)
+	}
 	err := b.protosClient.CloudManager.StopInstance(in.Name)
 	if err != nil {
 		return nil, fmt.Errorf("failed to stop instance '%s': %w", in.Name, err)
@@ -534,6 +730,9 @@ func (b *Backend) GetInstanceLogs(ctx context.Context, in *pbApic.GetInstanceLog
 
 func (b *Backend) InitDevInstance(ctx context.Context, in *pbApic.InitDevInstanceRequest) (*pbApic.InitDevInstanceResponse, error) {
 	log.Debugf("Initializing dev instance '%s' at '%s'", in.Name, in.Ip)
+	if err := b.checkNotStaged(); err != nil {
+		return nil, fmt.Errorf("failed to initialize dev instance '%s': %w", in.Name, err)
+	}
 
 	err := b.protosClient.CloudManager.InitDevInstance(in.Name, "local", "local", in.KeyFile, in.Ip)
 	if err != nil {
@@ -542,6 +741,326 @@ func (b *Backend) InitDevInstance(ctx context.Context, in *pbApic.InitDevInstanc
 	return &pbApic.InitDevInstanceResponse{}, nil
 }
 
+//
+// Network methods
+//
+
+func (b *Backend) GetNetworkStatus(ctx context.Context, in *pbApic.GetNetworkStatusRequest) (*pbApic.GetNetworkStatusResponse, error) {
+	var peers []*p2pproto.PeerNetworkStatus
+	if in.Instance == "" {
+		log.Debug("Retrieving local network status")
+		localPeers, err := b.protosClient.P2PManager.GetNetworkStatus(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve network status: %w", err)
+		}
+		peers = localPeers
+	} else {
+		log.Debugf("Retrieving network status for instance '%s'", in.Instance)
+		client, err := b.protosClient.P2PManager.GetClient(in.Instance)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve network status for instance '%s': %w", in.Instance, err)
+		}
+		resp, err := client.GetNetworkStatus(ctx, &p2pproto.GetNetworkStatusRequest{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve network status for instance '%s': %w", in.Instance, err)
+		}
+		peers = resp.Peers
+	}
+
+	resp := pbApic.GetNetworkStatusResponse{}
+	for _, peer := range peers {
+		respPeer := pbApic.NetworkPeer{
+			Name:      peer.Name,
+			Id:        peer.Id,
+			Connected: peer.Connected,
+			Transport: peer.Transport,
+			Relayed:   peer.Relayed,
+			Rtt:       peer.Rtt,
+			PingError: peer.PingError,
+		}
+		if peer.Wireguard != nil {
+			respPeer.Wireguard = &pbApic.WireguardStatus{
+				Endpoint:      peer.Wireguard.Endpoint,
+				LastHandshake: peer.Wireguard.LastHandshake,
+				RxBytes:       peer.Wireguard.RxBytes,
+				TxBytes:       peer.Wireguard.TxBytes,
+			}
+		}
+		resp.Peers = append(resp.Peers, &respPeer)
+	}
+
+	return &resp, nil
+}
+
+func (b *Backend) PingPeer(ctx context.Context, in *pbApic.PingPeerRequest) (*pbApic.PingPeerResponse, error) {
+	log.Debugf("Pinging peer '%s'", in.Name)
+	result := b.protosClient.PingPeer(ctx, in.Name)
+
+	resp := pbApic.PingPeerResponse{
+		P2PRtt:       result.P2PRTT.Microseconds(),
+		WireguardRtt: result.WireguardRTT.Microseconds(),
+	}
+	if result.P2PErr != nil {
+		resp.P2PError = result.P2PErr.Error()
+	}
+	if result.WireguardErr != nil {
+		resp.WireguardError = result.WireguardErr.Error()
+	}
+
+	return &resp, nil
+}
+
+//
+// DNS methods
+//
+
+func (b *Backend) GetDNSRecords(ctx context.Context, in *pbApic.GetDNSRecordsRequest) (*pbApic.GetDNSRecordsResponse, error) {
+	log.Debug("Retrieving DNS records")
+	records, err := b.protosClient.DNSRecordManager.GetRecords()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := pbApic.GetDNSRecordsResponse{Domain: b.protosClient.GetInternalDomain()}
+	for _, record := range records {
+		resp.Records = append(resp.Records, &pbApic.DNSRecord{Name: record.Name, Type: record.Type, Value: record.Value})
+	}
+	return &resp, nil
+}
+
+func (b *Backend) AddDNSRecord(ctx context.Context, in *pbApic.AddDNSRecordRequest) (*pbApic.AddDNSRecordResponse, error) {
+	if in.Record == nil {
+		return nil, fmt.Errorf("DNS record is required")
+	}
+	log.Debugf("Adding DNS record '%s' (%s)", in.Record.Name, in.Record.Type)
+	record, err := b.protosClient.DNSRecordManager.AddRecord(in.Record.Name, in.Record.Type, in.Record.Value)
+	if err != nil {
+		return nil, err
+	}
+	return &pbApic.AddDNSRecordResponse{Record: &pbApic.DNSRecord{Name: record.Name, Type: record.Type, Value: record.Value}}, nil
+}
+
+func (b *Backend) RemoveDNSRecord(ctx context.Context, in *pbApic.RemoveDNSRecordRequest) (*pbApic.RemoveDNSRecordResponse, error) {
+	log.Debugf("Removing DNS record '%s'", in.Name)
+	err := b.protosClient.DNSRecordManager.DeleteRecord(in.Name, in.Type)
+	if err != nil {
+		return nil, err
+	}
+	return &pbApic.RemoveDNSRecordResponse{}, nil
+}
+
+//
+// Event methods
+//
+
+func (b *Backend) WatchEvents(in *pbApic.WatchEventsRequest, stream pbApic.ProtosClientApi_WatchEventsServer) error {
+	log.Debugf("Watching events %v", in.Types)
+	events, unsubscribe := b.protosClient.P2PManager.Subscribe(in.Types...)
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			resp := &pbApic.Event{Type: p2p.EventType(event), Source: event.Source, Time: event.Timestamp}
+			switch payload := event.Payload.(type) {
+			case *p2pproto.Event_DbHead:
+				resp.Head = payload.DbHead.Head
+			case *p2pproto.Event_AppStatus:
+				resp.Name = payload.AppStatus.AppName
+				resp.Instance = payload.AppStatus.InstanceName
+				resp.Status = payload.AppStatus.Status
+			case *p2pproto.Event_InstanceStatus:
+				resp.Name = payload.InstanceStatus.InstanceName
+				resp.Status = payload.InstanceStatus.Status
+			case *p2pproto.Event_PeerJoin:
+				resp.Name = payload.PeerJoin.Name
+			case *p2pproto.Event_PeerLeave:
+				resp.Name = payload.PeerLeave.Name
+			}
+			err := stream.Send(resp)
+			if err != nil {
+				return fmt.Errorf("failed to send event: %w", err)
+			}
+		}
+	}
+}
+
+//
+// History methods
+//
+
+func historyCommitToProto(commit protosc.HistoryCommit) *pbApic.HistoryCommit {
+	return &pbApic.HistoryCommit{
+		Hash:       commit.Hash,
+		Signer:     commit.Signer,
+		SignerType: commit.SignerType,
+		Committer:  commit.Committer,
+		Time:       commit.Date.Unix(),
+		Message:    commit.Message,
+	}
+}
+
+func (b *Backend) GetHistory(ctx context.Context, in *pbApic.GetHistoryRequest) (*pbApic.GetHistoryResponse, error) {
+	log.Debugf("Retrieving history")
+	limit := int(in.Limit)
+	if limit <= 0 {
+		limit = 50
+	}
+	commits, err := b.protosClient.GetHistory(limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve history: %w", err)
+	}
+
+	resp := pbApic.GetHistoryResponse{}
+	for _, commit := range commits {
+		resp.Commits = append(resp.Commits, historyCommitToProto(commit))
+	}
+	return &resp, nil
+}
+
+func (b *Backend) GetHistoryCommit(ctx context.Context, in *pbApic.GetHistoryCommitRequest) (*pbApic.GetHistoryCommitResponse, error) {
+	log.Debugf("Retrieving commit '%s'", in.Hash)
+	commit, tables, err := b.protosClient.GetHistoryCommit(in.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve commit '%s': %w", in.Hash, err)
+	}
+
+	resp := pbApic.GetHistoryCommitResponse{Commit: historyCommitToProto(commit)}
+	for _, table := range tables {
+		resp.Tables = append(resp.Tables, &pbApic.TableChange{Table: table.Table, DataChange: table.DataChange, SchemaChange: table.SchemaChange})
+	}
+	return &resp, nil
+}
+
+func (b *Backend) DiffHistoryCommit(ctx context.Context, in *pbApic.DiffHistoryCommitRequest) (*pbApic.DiffHistoryCommitResponse, error) {
+	log.Debugf("Retrieving diff of commit '%s'", in.Hash)
+	tables := []string{in.Table}
+	if in.Table == "" {
+		_, changes, err := b.protosClient.GetHistoryCommit(in.Hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve commit '%s': %w", in.Hash, err)
+		}
+		tables = []string{}
+		for _, change := range changes {
+			if change.DataChange {
+				tables = append(tables, change.Table)
+			}
+		}
+	}
+
+	resp := pbApic.DiffHistoryCommitResponse{}
+	for _, table := range tables {
+		diffs, err := b.protosClient.DiffHistoryCommit(in.Hash, table)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve diff of commit '%s': %w", in.Hash, err)
+		}
+		tableDiff := &pbApic.TableDiff{Table: table}
+		for _, diff := range diffs {
+			tableDiff.Rows = append(tableDiff.Rows, &pbApic.RowDiff{Type: diff.Type, From: diff.From, To: diff.To})
+		}
+		resp.Tables = append(resp.Tables, tableDiff)
+	}
+	return &resp, nil
+}
+
+func (b *Backend) RevertHistoryCommit(ctx context.Context, in *pbApic.RevertHistoryCommitRequest) (*pbApic.RevertHistoryCommitResponse, error) {
+	log.Debugf("Reverting commit '%s'", in.Hash)
+	commit, err := b.protosClient.RevertHistoryCommit(in.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revert commit '%s': %w", in.Hash, err)
+	}
+	return &pbApic.RevertHistoryCommitResponse{Commit: commit}, nil
+}
+
+//
+// Changeset methods
+//
+
+// checkNotStaged refuses the instance operations while a changeset is open. These call the cloud providers right
+// away, so they can't be staged
+func (b *Backend) checkNotStaged() error {
+	if b.protosClient.HasChangeset() {
+		return fmt.Errorf("instance operations can't be staged, apply or discard the open changeset first")
+	}
+	return nil
+}
+
+func (b *Backend) GetChangeset(ctx context.Context, in *pbApic.GetChangesetRequest) (*pbApic.GetChangesetResponse, error) {
+	return &pbApic.GetChangesetResponse{Open: b.protosClient.HasChangeset()}, nil
+}
+
+func (b *Backend) OpenChangeset(ctx context.Context, in *pbApic.OpenChangesetRequest) (*pbApic.OpenChangesetResponse, error) {
+	log.Debugf("Opening changeset")
+	err := b.protosClient.OpenChangeset()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open changeset: %w", err)
+	}
+	return &pbApic.OpenChangesetResponse{}, nil
+}
+
+func (b *Backend) PlanChangeset(ctx context.Context, in *pbApic.PlanChangesetRequest) (*pbApic.PlanChangesetResponse, error) {
+	log.Debugf("Retrieving changeset plan")
+	tables, err := b.protosClient.PlanChangeset()
+	if err != nil {
+		return nil, fmt.Errorf("failed to plan changeset: %w", err)
+	}
+
+	resp := pbApic.PlanChangesetResponse{}
+	for _, table := range tables {
+		tableDiff := &pbApic.TableDiff{Table: table.Table}
+		for _, diff := range table.Rows {
+			tableDiff.Rows = append(tableDiff.Rows, &pbApic.RowDiff{Type: diff.Type, From: diff.From, To: diff.To})
+		}
+		resp.Tables = append(resp.Tables, tableDiff)
+	}
+	return &resp, nil
+}
+
+func (b *Backend) ApplyChangeset(ctx context.Context, in *pbApic.ApplyChangesetRequest) (*pbApic.ApplyChangesetResponse, error) {
+	log.Debugf("Applying changeset")
+	commit, err := b.protosClient.ApplyChangeset()
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply changeset: %w", err)
+	}
+	return &pbApic.ApplyChangesetResponse{Commit: commit}, nil
+}
+
+func (b *Backend) DiscardChangeset(ctx context.Context, in *pbApic.DiscardChangesetRequest) (*pbApic.DiscardChangesetResponse, error) {
+	log.Debugf("Discarding changeset")
+	err := b.protosClient.DiscardChangeset()
+	if err != nil {
+		return nil, fmt.Errorf("failed to discard changeset: %w", err)
+	}
+	return &pbApic.DiscardChangesetResponse{}, nil
+}
+
+//
+// State methods
+//
+
+func (b *Backend) ExportState(ctx context.Context, in *pbApic.ExportStateRequest) (*pbApic.ExportStateResponse, error) {
+	log.Debugf("Exporting state")
+	bundle, err := b.protosClient.ExportState(in.Passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export state: %w", err)
+	}
+	return &pbApic.ExportStateResponse{Bundle: bundle}, nil
+}
+
+func (b *Backend) ImportState(ctx context.Context, in *pbApic.ImportStateRequest) (*pbApic.ImportStateResponse, error) {
+	log.Debugf("Importing state")
+	err := b.protosClient.ImportState(in.Bundle, in.Passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import state: %w", err)
+	}
+	return &pbApic.ImportStateResponse{}, nil
+}
+
 //
 // Releases methods
 //
@@ -22,12 +22,18 @@ const (
 	ProtosClientApi_Init_FullMethodName                       = "/apic.ProtosClientApi/Init"
 	ProtosClientApi_GetUserDevices_FullMethodName             = "/apic.ProtosClientApi/GetUserDevices"
 	ProtosClientApi_GetUserInfo_FullMethodName                = "/apic.ProtosClientApi/GetUserInfo"
+	ProtosClientApi_AddExternalDevice_FullMethodName          = "/apic.ProtosClientApi/AddExternalDevice"
+	ProtosClientApi_ApproveDevice_FullMethodName              = "/apic.ProtosClientApi/ApproveDevice"
 	ProtosClientApi_GetApps_FullMethodName                    = "/apic.ProtosClientApi/GetApps"
 	ProtosClientApi_CreateApp_FullMethodName                  = "/apic.ProtosClientApi/CreateApp"
 	ProtosClientApi_StartApp_FullMethodName                   = "/apic.ProtosClientApi/StartApp"
 	ProtosClientApi_StopApp_FullMethodName                    = "/apic.ProtosClientApi/StopApp"
 	ProtosClientApi_RemoveApp_FullMethodName                  = "/apic.ProtosClientApi/RemoveApp"
 	ProtosClientApi_GetAppLogs_FullMethodName                 = "/apic.ProtosClientApi/GetAppLogs"
+	ProtosClientApi_ListAppFiles_FullMethodName               = "/apic.ProtosClientApi/ListAppFiles"
+	ProtosClientApi_PullAppFiles_FullMethodName               = "/apic.ProtosClientApi/PullAppFiles"
+	ProtosClientApi_PushAppFiles_FullMethodName               = "/apic.ProtosClientApi/PushAppFiles"
+	ProtosClientApi_PortForward_FullMethodName                = "/apic.ProtosClientApi/PortForward"
 	ProtosClientApi_GetSupportedCloudProviders_FullMethodName = "/apic.ProtosClientApi/GetSupportedCloudProviders"
 	ProtosClientApi_GetCloudProviders_FullMethodName          = "/apic.ProtosClientApi/GetCloudProviders"
 	ProtosClientApi_GetCloudProvider_FullMethodName           = "/apic.ProtosClientApi/GetCloudProvider"
@@ -46,6 +52,23 @@ const (
 	ProtosClientApi_GetCloudImages_FullMethodName             = "/apic.ProtosClientApi/GetCloudImages"
 	ProtosClientApi_UploadCloudImage_FullMethodName           = "/apic.ProtosClientApi/UploadCloudImage"
 	ProtosClientApi_RemoveCloudImage_FullMethodName           = "/apic.ProtosClientApi/RemoveCloudImage"
+	ProtosClientApi_GetNetworkStatus_FullMethodName           = "/apic.ProtosClientApi/GetNetworkStatus"
+	ProtosClientApi_PingPeer_FullMethodName                   = "/apic.ProtosClientApi/PingPeer"
+	ProtosClientApi_GetDNSRecords_FullMethodName              = "/apic.ProtosClientApi/GetDNSRecords"
+	ProtosClientApi_AddDNSRecord_FullMethodName               = "/apic.ProtosClientApi/AddDNSRecord"
+	ProtosClientApi_RemoveDNSRecord_FullMethodName            = "/apic.ProtosClientApi/RemoveDNSRecord"
+	ProtosClientApi_WatchEvents_FullMethodName                = "/apic.ProtosClientApi/WatchEvents"
+	ProtosClientApi_GetHistory_FullMethodName                 = "/apic.ProtosClientApi/GetHistory"
+	ProtosClientApi_GetHistoryCommit_FullMethodName           = "/apic.ProtosClientApi/GetHistoryCommit"
+	ProtosClientApi_DiffHistoryCommit_FullMethodName          = "/apic.ProtosClientApi/DiffHistoryCommit"
+	ProtosClientApi_RevertHistoryCommit_FullMethodName        = "/apic.ProtosClientApi/RevertHistoryCommit"
+	ProtosClientApi_GetChangeset_FullMethodName               = "/apic.ProtosClientApi/GetChangeset"
+	ProtosClientApi_OpenChangeset_FullMethodName              = "/apic.ProtosClientApi/OpenChangeset"
+	ProtosClientApi_PlanChangeset_FullMethodName              = "/apic.ProtosClientApi/PlanChangeset"
+	ProtosClientApi_ApplyChangeset_FullMethodName             = "/apic.ProtosClientApi/ApplyChangeset"
+	ProtosClientApi_DiscardChangeset_FullMethodName           = "/apic.ProtosClientApi/DiscardChangeset"
+	ProtosClientApi_ExportState_FullMethodName                = "/apic.ProtosClientApi/ExportState"
+	ProtosClientApi_ImportState_FullMethodName                = "/apic.ProtosClientApi/ImportState"
 	ProtosClientApi_GetBackupProviders_FullMethodName         = "/apic.ProtosClientApi/GetBackupProviders"
 	ProtosClientApi_GetBackupProviderInfo_FullMethodName      = "/apic.ProtosClientApi/GetBackupProviderInfo"
 	ProtosClientApi_GetBackups_FullMethodName                 = "/apic.ProtosClientApi/GetBackups"
@@ -63,6 +86,8 @@ type ProtosClientApiClient interface {
 	// User
 	GetUserDevices(ctx context.Context, in *GetUserDevicesRequest, opts ...grpc.CallOption) (*GetUserDevicesResponse, error)
 	GetUserInfo(ctx context.Context, in *GetUserInfoRequest, opts ...grpc.CallOption) (*GetUserInfoResponse, error)
+	AddExternalDevice(ctx context.Context, in *AddExternalDeviceRequest, opts ...grpc.CallOption) (*AddExternalDeviceResponse, error)
+	ApproveDevice(ctx context.Context, in *ApproveDeviceRequest, opts ...grpc.CallOption) (*ApproveDeviceResponse, error)
 	// App methods
 	GetApps(ctx context.Context, in *GetAppsRequest, opts ...grpc.CallOption) (*GetAppsResponse, error)
 	CreateApp(ctx context.Context, in *CreateAppRequest, opts ...grpc.CallOption) (*CreateAppResponse, error)
@@ -70,6 +95,10 @@ type ProtosClientApiClient interface {
 	StopApp(ctx context.Context, in *StopAppRequest, opts ...grpc.CallOption) (*StopAppResponse, error)
 	RemoveApp(ctx context.Context, in *RemoveAppRequest, opts ...grpc.CallOption) (*RemoveAppResponse, error)
 	GetAppLogs(ctx context.Context, in *GetAppLogsRequest, opts ...grpc.CallOption) (*GetAppLogsResponse, error)
+	ListAppFiles(ctx context.Context, in *ListAppFilesRequest, opts ...grpc.CallOption) (*ListAppFilesResponse, error)
+	PullAppFiles(ctx context.Context, in *PullAppFilesRequest, opts ...grpc.CallOption) (*PullAppFilesResponse, error)
+	PushAppFiles(ctx context.Context, in *PushAppFilesRequest, opts ...grpc.CallOption) (*PushAppFilesResponse, error)
+	PortForward(ctx context.Context, in *PortForwardRequest, opts ...grpc.CallOption) (ProtosClientApi_PortForwardClient, error)
 	// Cloud provider methods
 	GetSupportedCloudProviders(ctx context.Context, in *GetSupportedCloudProvidersRequest, opts ...grpc.CallOption) (*GetSupportedCloudProvidersResponse, error)
 	GetCloudProviders(ctx context.Context, in *GetCloudProvidersRequest, opts ...grpc.CallOption) (*GetCloudProvidersResponse, error)
@@ -91,6 +120,29 @@ type ProtosClientApiClient interface {
 	GetCloudImages(ctx context.Context, in *GetCloudImagesRequest, opts ...grpc.CallOption) (*GetCloudImagesResponse, error)
 	UploadCloudImage(ctx context.Context, in *UploadCloudImageRequest, opts ...grpc.CallOption) (*UploadCloudImageResponse, error)
 	RemoveCloudImage(ctx context.Context, in *RemoveCloudImageRequest, opts ...grpc.CallOption) (*RemoveCloudImageResponse, error)
+	// Network methods
+	GetNetworkStatus(ctx context.Context, in *GetNetworkStatusRequest, opts ...grpc.CallOption) (*GetNetworkStatusResponse, error)
+	PingPeer(ctx context.Context, in *PingPeerRequest, opts ...grpc.CallOption) (*PingPeerResponse, error)
+	// DNS methods
+	GetDNSRecords(ctx context.Context, in *GetDNSRecordsRequest, opts ...grpc.CallOption) (*GetDNSRecordsResponse, error)
+	AddDNSRecord(ctx context.Context, in *AddDNSRecordRequest, opts ...grpc.CallOption) (*AddDNSRecordResponse, error)
+	RemoveDNSRecord(ctx context.Context, in *RemoveDNSRecordRequest, opts ...grpc.CallOption) (*RemoveDNSRecordResponse, error)
+	// Event methods
+	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (ProtosClientApi_WatchEventsClient, error)
+	// History methods
+	GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*GetHistoryResponse, error)
+	GetHistoryCommit(ctx context.Context, in *GetHistoryCommitRequest, opts ...grpc.CallOption) (*GetHistoryCommitResponse, error)
+	DiffHistoryCommit(ctx context.Context, in *DiffHistoryCommitRequest, opts ...grpc.CallOption) (*DiffHistoryCommitResponse, error)
+	RevertHistoryCommit(ctx context.Context, in *RevertHistoryCommitRequest, opts ...grpc.CallOption) (*RevertHistoryCommitResponse, error)
+	// Changeset methods
+	GetChangeset(ctx context.Context, in *GetChangesetRequest, opts ...grpc.CallOption) (*GetChangesetResponse, error)
+	OpenChangeset(ctx context.Context, in *OpenChangesetRequest, opts ...grpc.CallOption) (*OpenChangesetResponse, error)
+	PlanChangeset(ctx context.Context, in *PlanChangesetRequest, opts ...grpc.CallOption) (*PlanChangesetResponse, error)
+	ApplyChangeset(ctx context.Context, in *ApplyChangesetRequest, opts ...grpc.CallOption) (*ApplyChangesetResponse, error)
+	DiscardChangeset(ctx context.Context, in *DiscardChangesetRequest, opts ...grpc.CallOption) (*DiscardChangesetResponse, error)
+	// State methods
+	ExportState(ctx context.Context, in *ExportStateRequest, opts ...grpc.CallOption) (*ExportStateResponse, error)
+	ImportState(ctx context.Context, in *ImportStateRequest, opts ...grpc.CallOption) (*ImportStateResponse, error)
 	// Backup methods
 	GetBackupProviders(ctx context.Context, in *GetBackupProvidersRequest, opts ...grpc.CallOption) (*GetBackupProvidersResponse, error)
 	GetBackupProviderInfo(ctx context.Context, in *GetBackupProviderInfoRequest, opts ...grpc.CallOption) (*GetBackupProviderInfoResponse, error)
@@ -135,6 +187,24 @@ func (c *protosClientApiClient) GetUserInfo(ctx context.Context, in *GetUserInfo
 	return out, nil
 }
 
+func (c *protosClientApiClient) AddExternalDevice(ctx context.Context, in *AddExternalDeviceRequest, opts ...grpc.CallOption) (*AddExternalDeviceResponse, error) {
+	out := new(AddExternalDeviceResponse)
+	err := c.cc.Invoke(ctx, ProtosClientApi_AddExternalDevice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protosClientApiClient) ApproveDevice(ctx context.Context, in *ApproveDeviceRequest, opts ...grpc.CallOption) (*ApproveDeviceResponse, error) {
+	out := new(ApproveDeviceResponse)
+	err := c.cc.Invoke(ctx, ProtosClientApi_ApproveDevice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *protosClientApiClient) GetApps(ctx context.Context, in *GetAppsRequest, opts ...grpc.CallOption) (*GetAppsResponse, error) {
 	out := new(GetAppsResponse)
 	err := c.cc.Invoke(ctx, ProtosClientApi_GetApps_FullMethodName, in, out, opts...)
@@ -189,6 +259,65 @@ func (c *protosClientApiClient) GetAppLogs(ctx context.Context, in *GetAppLogsRe
 	return out, nil
 }
 
+func (c *protosClientApiClient) ListAppFiles(ctx context.Context, in *ListAppFilesRequest, opts ...grpc.CallOption) (*ListAppFilesResponse, error) {
+	out := new(ListAppFilesResponse)
+	err := c.cc.Invoke(ctx, ProtosClientApi_ListAppFiles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protosClientApiClient) PullAppFiles(ctx context.Context, in *PullAppFilesRequest, opts ...grpc.CallOption) (*PullAppFilesResponse, error) {
+	out := new(PullAppFilesResponse)
+	err := c.cc.Invoke(ctx, ProtosClientApi_PullAppFiles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protosClientApiClient) PushAppFiles(ctx context.Context, in *PushAppFilesRequest, opts ...grpc.CallOption) (*PushAppFilesResponse, error) {
+	out := new(PushAppFilesResponse)
+	err := c.cc.Invoke(ctx, ProtosClientApi_PushAppFiles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protosClientApiClient) PortForward(ctx context.Context, in *PortForwardRequest, opts ...grpc.CallOption) (ProtosClientApi_PortForwardClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ProtosClientApi_ServiceDesc.Streams[0], ProtosClientApi_PortForward_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &protosClientApiPortForwardClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ProtosClientApi_PortForwardClient interface {
+	Recv() (*PortForwardEvent, error)
+	grpc.ClientStream
+}
+
+type protosClientApiPortForwardClient struct {
+	grpc.ClientStream
+}
+
+func (x *protosClientApiPortForwardClient) Recv() (*PortForwardEvent, error) {
+	m := new(PortForwardEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *protosClientApiClient) GetSupportedCloudProviders(ctx context.Context, in *GetSupportedCloudProvidersRequest, opts ...grpc.CallOption) (*GetSupportedCloudProvidersResponse, error) {
 	out := new(GetSupportedCloudProvidersResponse)
 	err := c.cc.Invoke(ctx, ProtosClientApi_GetSupportedCloudProviders_FullMethodName, in, out, opts...)
@@ -351,6 +480,182 @@ func (c *protosClientApiClient) RemoveCloudImage(ctx context.Context, in *Remove
 	return out, nil
 }
 
+func (c *protosClientApiClient) GetNetworkStatus(ctx context.Context, in *GetNetworkStatusRequest, opts ...grpc.CallOption) (*GetNetworkStatusResponse, error) {
+	out := new(GetNetworkStatusResponse)
+	err := c.cc.Invoke(ctx, ProtosClientApi_GetNetworkStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protosClientApiClient) PingPeer(ctx context.Context, in *PingPeerRequest, opts ...grpc.CallOption) (*PingPeerResponse, error) {
+	out := new(PingPeerResponse)
+	err := c.cc.Invoke(ctx, ProtosClientApi_PingPeer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protosClientApiClient) GetDNSRecords(ctx context.Context, in *GetDNSRecordsRequest, opts ...grpc.CallOption) (*GetDNSRecordsResponse, error) {
+	out := new(GetDNSRecordsResponse)
+	err := c.cc.Invoke(ctx, ProtosClientApi_GetDNSRecords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protosClientApiClient) AddDNSRecord(ctx context.Context, in *AddDNSRecordRequest, opts ...grpc.CallOption) (*AddDNSRecordResponse, error) {
+	out := new(AddDNSRecordResponse)
+	err := c.cc.Invoke(ctx, ProtosClientApi_AddDNSRecord_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protosClientApiClient) RemoveDNSRecord(ctx context.Context, in *RemoveDNSRecordRequest, opts ...grpc.CallOption) (*RemoveDNSRecordResponse, error) {
+	out := new(RemoveDNSRecordResponse)
+	err := c.cc.Invoke(ctx, ProtosClientApi_RemoveDNSRecord_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protosClientApiClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (ProtosClientApi_WatchEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ProtosClientApi_ServiceDesc.Streams[1], ProtosClientApi_WatchEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &protosClientApiWatchEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ProtosClientApi_WatchEventsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type protosClientApiWatchEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *protosClientApiWatchEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *protosClientApiClient) GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*GetHistoryResponse, error) {
+	out := new(GetHistoryResponse)
+	err := c.cc.Invoke(ctx, ProtosClientApi_GetHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protosClientApiClient) GetHistoryCommit(ctx context.Context, in *GetHistoryCommitRequest, opts ...grpc.CallOption) (*GetHistoryCommitResponse, error) {
+	out := new(GetHistoryCommitResponse)
+	err := c.cc.Invoke(ctx, ProtosClientApi_GetHistoryCommit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protosClientApiClient) DiffHistoryCommit(ctx context.Context, in *DiffHistoryCommitRequest, opts ...grpc.CallOption) (*DiffHistoryCommitResponse, error) {
+	out := new(DiffHistoryCommitResponse)
+	err := c.cc.Invoke(ctx, ProtosClientApi_DiffHistoryCommit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protosClientApiClient) RevertHistoryCommit(ctx context.Context, in *RevertHistoryCommitRequest, opts ...grpc.CallOption) (*RevertHistoryCommitResponse, error) {
+	out := new(RevertHistoryCommitResponse)
+	err := c.cc.Invoke(ctx, ProtosClientApi_RevertHistoryCommit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protosClientApiClient) GetChangeset(ctx context.Context, in *GetChangesetRequest, opts ...grpc.CallOption) (*GetChangesetResponse, error) {
+	out := new(GetChangesetResponse)
+	err := c.cc.Invoke(ctx, ProtosClientApi_GetChangeset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protosClientApiClient) OpenChangeset(ctx context.Context, in *OpenChangesetRequest, opts ...grpc.CallOption) (*OpenChangesetResponse, error) {
+	out := new(OpenChangesetResponse)
+	err := c.cc.Invoke(ctx, ProtosClientApi_OpenChangeset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protosClientApiClient) PlanChangeset(ctx context.Context, in *PlanChangesetRequest, opts ...grpc.CallOption) (*PlanChangesetResponse, error) {
+	out := new(PlanChangesetResponse)
+	err := c.cc.Invoke(ctx, ProtosClientApi_PlanChangeset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protosClientApiClient) ApplyChangeset(ctx context.Context, in *ApplyChangesetRequest, opts ...grpc.CallOption) (*ApplyChangesetResponse, error) {
+	out := new(ApplyChangesetResponse)
+	err := c.cc.Invoke(ctx, ProtosClientApi_ApplyChangeset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protosClientApiClient) DiscardChangeset(ctx context.Context, in *DiscardChangesetRequest, opts ...grpc.CallOption) (*DiscardChangesetResponse, error) {
+	out := new(DiscardChangesetResponse)
+	err := c.cc.Invoke(ctx, ProtosClientApi_DiscardChangeset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protosClientApiClient) ExportState(ctx context.Context, in *ExportStateRequest, opts ...grpc.CallOption) (*ExportStateResponse, error) {
+	out := new(ExportStateResponse)
+	err := c.cc.Invoke(ctx, ProtosClientApi_ExportState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protosClientApiClient) ImportState(ctx context.Context, in *ImportStateRequest, opts ...grpc.CallOption) (*ImportStateResponse, error) {
+	out := new(ImportStateResponse)
+	err := c.cc.Invoke(ctx, ProtosClientApi_ImportState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *protosClientApiClient) GetBackupProviders(ctx context.Context, in *GetBackupProvidersRequest, opts ...grpc.CallOption) (*GetBackupProvidersResponse, error) {
 	out := new(GetBackupProvidersResponse)
 	err := c.cc.Invoke(ctx, ProtosClientApi_GetBackupProviders_FullMethodName, in, out, opts...)
@@ -414,6 +719,8 @@ type ProtosClientApiServer interface {
 	// User
 	GetUserDevices(context.Context, *GetUserDevicesRequest) (*GetUserDevicesResponse, error)
 	GetUserInfo(context.Context, *GetUserInfoRequest) (*GetUserInfoResponse, error)
+	AddExternalDevice(context.Context, *AddExternalDeviceRequest) (*AddExternalDeviceResponse, error)
+	ApproveDevice(context.Context, *ApproveDeviceRequest) (*ApproveDeviceResponse, error)
 	// App methods
 	GetApps(context.Context, *GetAppsRequest) (*GetAppsResponse, error)
 	CreateApp(context.Context, *CreateAppRequest) (*CreateAppResponse, error)
@@ -421,6 +728,10 @@ type ProtosClientApiServer interface {
 	StopApp(context.Context, *StopAppRequest) (*StopAppResponse, error)
 	RemoveApp(context.Context, *RemoveAppRequest) (*RemoveAppResponse, error)
 	GetAppLogs(context.Context, *GetAppLogsRequest) (*GetAppLogsResponse, error)
+	ListAppFiles(context.Context, *ListAppFilesRequest) (*ListAppFilesResponse, error)
+	PullAppFiles(context.Context, *PullAppFilesRequest) (*PullAppFilesResponse, error)
+	PushAppFiles(context.Context, *PushAppFilesRequest) (*PushAppFilesResponse, error)
+	PortForward(*PortForwardRequest, ProtosClientApi_PortForwardServer) error
 	// Cloud provider methods
 	GetSupportedCloudProviders(context.Context, *GetSupportedCloudProvidersRequest) (*GetSupportedCloudProvidersResponse, error)
 	GetCloudProviders(context.Context, *GetCloudProvidersRequest) (*GetCloudProvidersResponse, error)
@@ -442,6 +753,29 @@ type ProtosClientApiServer interface {
 	GetCloudImages(context.Context, *GetCloudImagesRequest) (*GetCloudImagesResponse, error)
 	UploadCloudImage(context.Context, *UploadCloudImageRequest) (*UploadCloudImageResponse, error)
 	RemoveCloudImage(context.Context, *RemoveCloudImageRequest) (*RemoveCloudImageResponse, error)
+	// Network methods
+	GetNetworkStatus(context.Context, *GetNetworkStatusRequest) (*GetNetworkStatusResponse, error)
+	PingPeer(context.Context, *PingPeerRequest) (*PingPeerResponse, error)
+	// DNS methods
+	GetDNSRecords(context.Context, *GetDNSRecordsRequest) (*GetDNSRecordsResponse, error)
+	AddDNSRecord(context.Context, *AddDNSRecordRequest) (*AddDNSRecordResponse, error)
+	RemoveDNSRecord(context.Context, *RemoveDNSRecordRequest) (*RemoveDNSRecordResponse, error)
+	// Event methods
+	WatchEvents(*WatchEventsRequest, ProtosClientApi_WatchEventsServer) error
+	// History methods
+	GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error)
+	GetHistoryCommit(context.Context, *GetHistoryCommitRequest) (*GetHistoryCommitResponse, error)
+	DiffHistoryCommit(context.Context, *DiffHistoryCommitRequest) (*DiffHistoryCommitResponse, error)
+	RevertHistoryCommit(context.Context, *RevertHistoryCommitRequest) (*RevertHistoryCommitResponse, error)
+	// Changeset methods
+	GetChangeset(context.Context, *GetChangesetRequest) (*GetChangesetResponse, error)
+	OpenChangeset(context.Context, *OpenChangesetRequest) (*OpenChangesetResponse, error)
+	PlanChangeset(context.Context, *PlanChangesetRequest) (*PlanChangesetResponse, error)
+	ApplyChangeset(context.Context, *ApplyChangesetRequest) (*ApplyChangesetResponse, error)
+	DiscardChangeset(context.Context, *DiscardChangesetRequest) (*DiscardChangesetResponse, error)
+	// State methods
+	ExportState(context.Context, *ExportStateRequest) (*ExportStateResponse, error)
+	ImportState(context.Context, *ImportStateRequest) (*ImportStateResponse, error)
 	// Backup methods
 	GetBackupProviders(context.Context, *GetBackupProvidersRequest) (*GetBackupProvidersResponse, error)
 	GetBackupProviderInfo(context.Context, *GetBackupProviderInfoRequest) (*GetBackupProviderInfoResponse, error)
@@ -464,6 +798,12 @@ func (UnimplementedProtosClientApiServer) GetUserDevices(context.Context, *GetUs
 func (UnimplementedProtosClientApiServer) GetUserInfo(context.Context, *GetUserInfoRequest) (*GetUserInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserInfo not implemented")
 }
+func (UnimplementedProtosClientApiServer) AddExternalDevice(context.Context, *AddExternalDeviceRequest) (*AddExternalDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddExternalDevice not implemented")
+}
+func (UnimplementedProtosClientApiServer) ApproveDevice(context.Context, *ApproveDeviceRequest) (*ApproveDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveDevice not implemented")
+}
 func (UnimplementedProtosClientApiServer) GetApps(context.Context, *GetAppsRequest) (*GetAppsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetApps not implemented")
 }
@@ -482,6 +822,18 @@ func (UnimplementedProtosClientApiServer) RemoveApp(context.Context, *RemoveAppR
 func (UnimplementedProtosClientApiServer) GetAppLogs(context.Context, *GetAppLogsRequest) (*GetAppLogsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAppLogs not implemented")
 }
+func (UnimplementedProtosClientApiServer) ListAppFiles(context.Context, *ListAppFilesRequest) (*ListAppFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppFiles not implemented")
+}
+func (UnimplementedProtosClientApiServer) PullAppFiles(context.Context, *PullAppFilesRequest) (*PullAppFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullAppFiles not implemented")
+}
+func (UnimplementedProtosClientApiServer) PushAppFiles(context.Context, *PushAppFilesRequest) (*PushAppFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushAppFiles not implemented")
+}
+func (UnimplementedProtosClientApiServer) PortForward(*PortForwardRequest, ProtosClientApi_PortForwardServer) error {
+	return status.Errorf(codes.Unimplemented, "method PortForward not implemented")
+}
 func (UnimplementedProtosClientApiServer) GetSupportedCloudProviders(context.Context, *GetSupportedCloudProvidersRequest) (*GetSupportedCloudProvidersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSupportedCloudProviders not implemented")
 }
@@ -536,6 +888,57 @@ func (UnimplementedProtosClientApiServer) UploadCloudImage(context.Context, *Upl
 func (UnimplementedProtosClientApiServer) RemoveCloudImage(context.Context, *RemoveCloudImageRequest) (*RemoveCloudImageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveCloudImage not implemented")
 }
+func (UnimplementedProtosClientApiServer) GetNetworkStatus(context.Context, *GetNetworkStatusRequest) (*GetNetworkStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkStatus not implemented")
+}
+func (UnimplementedProtosClientApiServer) PingPeer(context.Context, *PingPeerRequest) (*PingPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PingPeer not implemented")
+}
+func (UnimplementedProtosClientApiServer) GetDNSRecords(context.Context, *GetDNSRecordsRequest) (*GetDNSRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDNSRecords not implemented")
+}
+func (UnimplementedProtosClientApiServer) AddDNSRecord(context.Context, *AddDNSRecordRequest) (*AddDNSRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDNSRecord not implemented")
+}
+func (UnimplementedProtosClientApiServer) RemoveDNSRecord(context.Context, *RemoveDNSRecordRequest) (*RemoveDNSRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDNSRecord not implemented")
+}
+func (UnimplementedProtosClientApiServer) WatchEvents(*WatchEventsRequest, ProtosClientApi_WatchEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
+func (UnimplementedProtosClientApiServer) GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistory not implemented")
+}
+func (UnimplementedProtosClientApiServer) GetHistoryCommit(context.Context, *GetHistoryCommitRequest) (*GetHistoryCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistoryCommit not implemented")
+}
+func (UnimplementedProtosClientApiServer) DiffHistoryCommit(context.Context, *DiffHistoryCommitRequest) (*DiffHistoryCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffHistoryCommit not implemented")
+}
+func (UnimplementedProtosClientApiServer) RevertHistoryCommit(context.Context, *RevertHistoryCommitRequest) (*RevertHistoryCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertHistoryCommit not implemented")
+}
+func (UnimplementedProtosClientApiServer) GetChangeset(context.Context, *GetChangesetRequest) (*GetChangesetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChangeset not implemented")
+}
+func (UnimplementedProtosClientApiServer) OpenChangeset(context.Context, *OpenChangesetRequest) (*OpenChangesetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenChangeset not implemented")
+}
+func (UnimplementedProtosClientApiServer) PlanChangeset(context.Context, *PlanChangesetRequest) (*PlanChangesetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanChangeset not implemented")
+}
+func (UnimplementedProtosClientApiServer) ApplyChangeset(context.Context, *ApplyChangesetRequest) (*ApplyChangesetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyChangeset not implemented")
+}
+func (UnimplementedProtosClientApiServer) DiscardChangeset(context.Context, *DiscardChangesetRequest) (*DiscardChangesetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscardChangeset not implemented")
+}
+func (UnimplementedProtosClientApiServer) ExportState(context.Context, *ExportStateRequest) (*ExportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportState not implemented")
+}
+func (UnimplementedProtosClientApiServer) ImportState(context.Context, *ImportStateRequest) (*ImportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportState not implemented")
+}
 func (UnimplementedProtosClientApiServer) GetBackupProviders(context.Context, *GetBackupProvidersRequest) (*GetBackupProvidersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBackupProviders not implemented")
 }
@@ -620,6 +1023,42 @@ func _ProtosClientApi_GetUserInfo_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProtosClientApi_AddExternalDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddExternalDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtosClientApiServer).AddExternalDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProtosClientApi_AddExternalDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtosClientApiServer).AddExternalDevice(ctx, req.(*AddExternalDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtosClientApi_ApproveDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtosClientApiServer).ApproveDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProtosClientApi_ApproveDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtosClientApiServer).ApproveDevice(ctx, req.(*ApproveDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProtosClientApi_GetApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAppsRequest)
 	if err := dec(in); err != nil {
@@ -728,99 +1167,174 @@ func _ProtosClientApi_GetAppLogs_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProtosClientApi_GetSupportedCloudProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSupportedCloudProvidersRequest)
+func _ProtosClientApi_ListAppFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppFilesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProtosClientApiServer).GetSupportedCloudProviders(ctx, in)
+		return srv.(ProtosClientApiServer).ListAppFiles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProtosClientApi_GetSupportedCloudProviders_FullMethodName,
+		FullMethod: ProtosClientApi_ListAppFiles_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProtosClientApiServer).GetSupportedCloudProviders(ctx, req.(*GetSupportedCloudProvidersRequest))
+		return srv.(ProtosClientApiServer).ListAppFiles(ctx, req.(*ListAppFilesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProtosClientApi_GetCloudProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCloudProvidersRequest)
+func _ProtosClientApi_PullAppFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullAppFilesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProtosClientApiServer).GetCloudProviders(ctx, in)
+		return srv.(ProtosClientApiServer).PullAppFiles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProtosClientApi_GetCloudProviders_FullMethodName,
+		FullMethod: ProtosClientApi_PullAppFiles_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProtosClientApiServer).GetCloudProviders(ctx, req.(*GetCloudProvidersRequest))
+		return srv.(ProtosClientApiServer).PullAppFiles(ctx, req.(*PullAppFilesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProtosClientApi_GetCloudProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCloudProviderRequest)
+func _ProtosClientApi_PushAppFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushAppFilesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProtosClientApiServer).GetCloudProvider(ctx, in)
+		return srv.(ProtosClientApiServer).PushAppFiles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProtosClientApi_GetCloudProvider_FullMethodName,
+		FullMethod: ProtosClientApi_PushAppFiles_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProtosClientApiServer).GetCloudProvider(ctx, req.(*GetCloudProviderRequest))
+		return srv.(ProtosClientApiServer).PushAppFiles(ctx, req.(*PushAppFilesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProtosClientApi_AddCloudProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddCloudProviderRequest)
+func _ProtosClientApi_PortForward_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PortForwardRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProtosClientApiServer).PortForward(m, &protosClientApiPortForwardServer{stream})
+}
+
+type ProtosClientApi_PortForwardServer interface {
+	Send(*PortForwardEvent) error
+	grpc.ServerStream
+}
+
+type protosClientApiPortForwardServer struct {
+	grpc.ServerStream
+}
+
+func (x *protosClientApiPortForwardServer) Send(m *PortForwardEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ProtosClientApi_GetSupportedCloudProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSupportedCloudProvidersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProtosClientApiServer).AddCloudProvider(ctx, in)
+		return srv.(ProtosClientApiServer).GetSupportedCloudProviders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProtosClientApi_AddCloudProvider_FullMethodName,
+		FullMethod: ProtosClientApi_GetSupportedCloudProviders_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProtosClientApiServer).AddCloudProvider(ctx, req.(*AddCloudProviderRequest))
+		return srv.(ProtosClientApiServer).GetSupportedCloudProviders(ctx, req.(*GetSupportedCloudProvidersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProtosClientApi_RemoveCloudProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveCloudProviderRequest)
+func _ProtosClientApi_GetCloudProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCloudProvidersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProtosClientApiServer).RemoveCloudProvider(ctx, in)
+		return srv.(ProtosClientApiServer).GetCloudProviders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProtosClientApi_RemoveCloudProvider_FullMethodName,
+		FullMethod: ProtosClientApi_GetCloudProviders_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProtosClientApiServer).RemoveCloudProvider(ctx, req.(*RemoveCloudProviderRequest))
+		return srv.(ProtosClientApiServer).GetCloudProviders(ctx, req.(*GetCloudProvidersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProtosClientApi_GetInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInstancesRequest)
-	if err := dec(in); err != nil {
+func _ProtosClientApi_GetCloudProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCloudProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtosClientApiServer).GetCloudProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProtosClientApi_GetCloudProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtosClientApiServer).GetCloudProvider(ctx, req.(*GetCloudProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtosClientApi_AddCloudProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCloudProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtosClientApiServer).AddCloudProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProtosClientApi_AddCloudProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtosClientApiServer).AddCloudProvider(ctx, req.(*AddCloudProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtosClientApi_RemoveCloudProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCloudProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtosClientApiServer).RemoveCloudProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProtosClientApi_RemoveCloudProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtosClientApiServer).RemoveCloudProvider(ctx, req.(*RemoveCloudProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtosClientApi_GetInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstancesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
@@ -1052,6 +1566,315 @@ func _ProtosClientApi_RemoveCloudImage_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProtosClientApi_GetNetworkStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetworkStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtosClientApiServer).GetNetworkStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProtosClientApi_GetNetworkStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtosClientApiServer).GetNetworkStatus(ctx, req.(*GetNetworkStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtosClientApi_PingPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtosClientApiServer).PingPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProtosClientApi_PingPeer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtosClientApiServer).PingPeer(ctx, req.(*PingPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtosClientApi_GetDNSRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDNSRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtosClientApiServer).GetDNSRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProtosClientApi_GetDNSRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtosClientApiServer).GetDNSRecords(ctx, req.(*GetDNSRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtosClientApi_AddDNSRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDNSRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtosClientApiServer).AddDNSRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProtosClientApi_AddDNSRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtosClientApiServer).AddDNSRecord(ctx, req.(*AddDNSRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtosClientApi_RemoveDNSRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDNSRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtosClientApiServer).RemoveDNSRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProtosClientApi_RemoveDNSRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtosClientApiServer).RemoveDNSRecord(ctx, req.(*RemoveDNSRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtosClientApi_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProtosClientApiServer).WatchEvents(m, &protosClientApiWatchEventsServer{stream})
+}
+
+type ProtosClientApi_WatchEventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type protosClientApiWatchEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *protosClientApiWatchEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ProtosClientApi_GetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtosClientApiServer).GetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProtosClientApi_GetHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtosClientApiServer).GetHistory(ctx, req.(*GetHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtosClientApi_GetHistoryCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHistoryCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtosClientApiServer).GetHistoryCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProtosClientApi_GetHistoryCommit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtosClientApiServer).GetHistoryCommit(ctx, req.(*GetHistoryCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtosClientApi_DiffHistoryCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffHistoryCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtosClientApiServer).DiffHistoryCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProtosClientApi_DiffHistoryCommit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtosClientApiServer).DiffHistoryCommit(ctx, req.(*DiffHistoryCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtosClientApi_RevertHistoryCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertHistoryCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtosClientApiServer).RevertHistoryCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProtosClientApi_RevertHistoryCommit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtosClientApiServer).RevertHistoryCommit(ctx, req.(*RevertHistoryCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtosClientApi_GetChangeset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChangesetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtosClientApiServer).GetChangeset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProtosClientApi_GetChangeset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtosClientApiServer).GetChangeset(ctx, req.(*GetChangesetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtosClientApi_OpenChangeset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenChangesetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtosClientApiServer).OpenChangeset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProtosClientApi_OpenChangeset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtosClientApiServer).OpenChangeset(ctx, req.(*OpenChangesetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtosClientApi_PlanChangeset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanChangesetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtosClientApiServer).PlanChangeset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProtosClientApi_PlanChangeset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtosClientApiServer).PlanChangeset(ctx, req.(*PlanChangesetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtosClientApi_ApplyChangeset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyChangesetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtosClientApiServer).ApplyChangeset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProtosClientApi_ApplyChangeset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtosClientApiServer).ApplyChangeset(ctx, req.(*ApplyChangesetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtosClientApi_DiscardChangeset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardChangesetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtosClientApiServer).DiscardChangeset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProtosClientApi_DiscardChangeset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtosClientApiServer).DiscardChangeset(ctx, req.(*DiscardChangesetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtosClientApi_ExportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtosClientApiServer).ExportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProtosClientApi_ExportState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtosClientApiServer).ExportState(ctx, req.(*ExportStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtosClientApi_ImportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtosClientApiServer).ImportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProtosClientApi_ImportState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtosClientApiServer).ImportState(ctx, req.(*ImportStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProtosClientApi_GetBackupProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBackupProvidersRequest)
 	if err := dec(in); err != nil {
@@ -1179,6 +2002,14 @@ var ProtosClientApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserInfo",
 			Handler:    _ProtosClientApi_GetUserInfo_Handler,
 		},
+		{
+			MethodName: "AddExternalDevice",
+			Handler:    _ProtosClientApi_AddExternalDevice_Handler,
+		},
+		{
+			MethodName: "ApproveDevice",
+			Handler:    _ProtosClientApi_ApproveDevice_Handler,
+		},
 		{
 			MethodName: "GetApps",
 			Handler:    _ProtosClientApi_GetApps_Handler,
@@ -1203,6 +2034,18 @@ var ProtosClientApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAppLogs",
 			Handler:    _ProtosClientApi_GetAppLogs_Handler,
 		},
+		{
+			MethodName: "ListAppFiles",
+			Handler:    _ProtosClientApi_ListAppFiles_Handler,
+		},
+		{
+			MethodName: "PullAppFiles",
+			Handler:    _ProtosClientApi_PullAppFiles_Handler,
+		},
+		{
+			MethodName: "PushAppFiles",
+			Handler:    _ProtosClientApi_PushAppFiles_Handler,
+		},
 		{
 			MethodName: "GetSupportedCloudProviders",
 			Handler:    _ProtosClientApi_GetSupportedCloudProviders_Handler,
@@ -1275,6 +2118,70 @@ var ProtosClientApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveCloudImage",
 			Handler:    _ProtosClientApi_RemoveCloudImage_Handler,
 		},
+		{
+			MethodName: "GetNetworkStatus",
+			Handler:    _ProtosClientApi_GetNetworkStatus_Handler,
+		},
+		{
+			MethodName: "PingPeer",
+			Handler:    _ProtosClientApi_PingPeer_Handler,
+		},
+		{
+			MethodName: "GetDNSRecords",
+			Handler:    _ProtosClientApi_GetDNSRecords_Handler,
+		},
+		{
+			MethodName: "AddDNSRecord",
+			Handler:    _ProtosClientApi_AddDNSRecord_Handler,
+		},
+		{
+			MethodName: "RemoveDNSRecord",
+			Handler:    _ProtosClientApi_RemoveDNSRecord_Handler,
+		},
+		{
+			MethodName: "GetHistory",
+			Handler:    _ProtosClientApi_GetHistory_Handler,
+		},
+		{
+			MethodName: "GetHistoryCommit",
+			Handler:    _ProtosClientApi_GetHistoryCommit_Handler,
+		},
+		{
+			MethodName: "DiffHistoryCommit",
+			Handler:    _ProtosClientApi_DiffHistoryCommit_Handler,
+		},
+		{
+			MethodName: "RevertHistoryCommit",
+			Handler:    _ProtosClientApi_RevertHistoryCommit_Handler,
+		},
+		{
+			MethodName: "GetChangeset",
+			Handler:    _ProtosClientApi_GetChangeset_Handler,
+		},
+		{
+			MethodName: "OpenChangeset",
+			Handler:    _ProtosClientApi_OpenChangeset_Handler,
+		},
+		{
+			MethodName: "PlanChangeset",
+			Handler:    _ProtosClientApi_PlanChangeset_Handler,
+		},
+		{
+			MethodName: "ApplyChangeset",
+			Handler:    _ProtosClientApi_ApplyChangeset_Handler,
+		},
+		{
+			MethodName: "DiscardChangeset",
+			Handler:    _ProtosClientApi_DiscardChangeset_Handler,
+		},
+		{
+			MethodName: "ExportState",
+			Handler:    _ProtosClientApi_ExportState_Handler,
+		},
+		{
+			MethodName: "ImportState",
+			Handler:    _ProtosClientApi_ImportState_Handler,
+		},
 		{
 			MethodName: "GetBackupProviders",
 			Handler:    _ProtosClientApi_GetBackupProviders_Handler,
@@ -1300,6 +2207,17 @@ var ProtosClientApi_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _ProtosClientApi_RemoveBackup_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PortForward",
+			Handler:       _ProtosClientApi_PortForward_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _ProtosClientApi_WatchEvents_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "apic/proto/apic.proto",
 }
@@ -50,6 +50,15 @@ func main() {
 			cmdApp,
 			cmdCloud,
 			cmdInstance,
+			cmdDevice,
+			cmdNetwork,
+			cmdDNS,
+			cmdEvents,
+			cmdHistory,
+			cmdChangeset,
+			cmdPlan,
+			cmdApply,
+			cmdState,
 			cmdRelease,
 			cmdBackup,
 		},
@@ -2,8 +2,15 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
+	"net"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"text/tabwriter"
 	"time"
 
@@ -32,6 +39,16 @@ var cmdApp *cli.Command = &cli.Command{
 					Aliases: []string{"s"},
 					Usage:   "add persistent state to app",
 				},
+				&cli.StringSliceFlag{
+					Name:    "port",
+					Aliases: []string{"p"},
+					Usage:   "publish `PORT` in the [name:]number[/protocol] format (e.g. http:80/tcp). Can be repeated",
+				},
+				&cli.StringSliceFlag{
+					Name:    "capability",
+					Aliases: []string{"c"},
+					Usage:   "grant `CAPABILITY` to the app (e.g. PublicDNS). Can be repeated",
+				},
 			},
 			Action: func(c *cli.Context) error {
 				name := c.Args().Get(0)
@@ -52,7 +69,7 @@ var cmdApp *cli.Command = &cli.Command{
 					os.Exit(1)
 				}
 
-				return createApp(name, installerID, instanceID, c.Bool("state"))
+				return createApp(name, installerID, instanceID, c.Bool("state"), c.StringSlice("port"), c.StringSlice("capability"))
 			},
 		},
 		{
@@ -111,6 +128,50 @@ var cmdApp *cli.Command = &cli.Command{
 				return getAppLogs(name)
 			},
 		},
+		{
+			Name:      "files",
+			ArgsUsage: "<name> [path]",
+			Usage:     "List the files in the data volume of an application",
+			Action: func(c *cli.Context) error {
+				name := c.Args().Get(0)
+				if name == "" {
+					cli.ShowSubcommandHelp(c)
+					os.Exit(1)
+				}
+
+				return listAppFiles(name, c.Args().Get(1))
+			},
+		},
+		{
+			Name:      "cp",
+			ArgsUsage: "<source> <destination>",
+			Usage:     "Copy files and directories between the local machine and the data volume of an application. Paths inside an application are written as <name>:<path>",
+			Action: func(c *cli.Context) error {
+				source := c.Args().Get(0)
+				destination := c.Args().Get(1)
+				if source == "" || destination == "" {
+					cli.ShowSubcommandHelp(c)
+					os.Exit(1)
+				}
+
+				return copyAppFiles(source, destination)
+			},
+		},
+		{
+			Name:      "port-forward",
+			ArgsUsage: "<name> [<address>:]<local port>:<remote port>",
+			Usage:     "Forward connections made to a local port to a port of an application, until interrupted",
+			Action: func(c *cli.Context) error {
+				name := c.Args().Get(0)
+				ports := c.Args().Get(1)
+				if name == "" || ports == "" {
+					cli.ShowSubcommandHelp(c)
+					os.Exit(1)
+				}
+
+				return portForwardApp(name, ports)
+			},
+		},
 	},
 }
 
@@ -127,20 +188,24 @@ func listApps() error {
 
 	defer w.Flush()
 
-	fmt.Fprintf(w, " %s\t%s\t%s\t%s\t%s\t%s\t", "Name", "ID", "Installer", "Status (desired)", "Instance", "IP")
-	fmt.Fprintf(w, "\n %s\t%s\t%s\t%s\t%s\t%s\t", "----", "--", "---------", "-------------", "--------", "--")
+	fmt.Fprintf(w, " %s\t%s\t%s\t%s\t%s\t%s\t%s\t", "Name", "ID", "Installer", "Status (desired)", "Instance", "IP", "Ports")
+	fmt.Fprintf(w, "\n %s\t%s\t%s\t%s\t%s\t%s\t%s\t", "----", "--", "---------", "-------------", "--------", "--", "-----")
 	for _, appi := range resp.Apps {
-		fmt.Fprintf(w, "\n %s\t%s\t%s\t%s\t%s\t%s\t", appi.Name, appi.Id, appi.Installer, appi.Status, appi.InstanceName, appi.Ip)
+		ports := "-"
+		if len(appi.Ports) > 0 {
+			ports = strings.Join(appi.Ports, ",")
+		}
+		fmt.Fprintf(w, "\n %s\t%s\t%s\t%s\t%s\t%s\t%s\t", appi.Name, appi.Id, appi.Installer, appi.Status, appi.InstanceName, appi.Ip, ports)
 	}
 	fmt.Fprint(w, "\n")
 
 	return nil
 }
 
-func createApp(name string, installerID string, instanceID string, persistence bool) error {
+func createApp(name string, installerID string, instanceID string, persistence bool, ports []string, capabilities []string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	_, err := client.CreateApp(ctx, &pbApic.CreateAppRequest{Name: name, InstallerId: installerID, InstanceId: instanceID, Persistence: persistence})
+	_, err := client.CreateApp(ctx, &pbApic.CreateAppRequest{Name: name, InstallerId: installerID, InstanceId: instanceID, Persistence: persistence, Ports: ports, Capabilities: capabilities})
 	if err != nil {
 		return fmt.Errorf("failed to run app '%s': %w", name, err)
 	}
@@ -187,3 +252,124 @@ func getAppLogs(name string) error {
 	fmt.Println(string(resp.Logs))
 	return nil
 }
+
+func listAppFiles(name string, path string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	resp, err := client.ListAppFiles(ctx, &pbApic.ListAppFilesRequest{Name: name, Path: path})
+	if err != nil {
+		return fmt.Errorf("failed to list files for app '%s': %w", name, err)
+	}
+	if len(resp.Files) == 0 {
+		return fmt.Errorf("'%s' does not exist in the data volume of app '%s'", path, name)
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 0, 0, 2, ' ', 0)
+
+	defer w.Flush()
+
+	fmt.Fprintf(w, " %s\t%s\t%s\t%s\t", "Mode", "Size", "Modified", "Path")
+	fmt.Fprintf(w, "\n %s\t%s\t%s\t%s\t", "----", "----", "--------", "----")
+	for _, file := range resp.Files {
+		mode := fs.FileMode(file.Mode)
+		if file.IsDir {
+			mode |= fs.ModeDir
+		}
+		fmt.Fprintf(w, "\n %s\t%d\t%s\t%s\t", mode.String(), file.Size, time.Unix(file.ModTime, 0).Format(time.RFC3339), file.Path)
+	}
+	fmt.Fprint(w, "\n")
+
+	return nil
+}
+
+// splitAppPath splits a <name>:<path> argument. Local paths are returned with an empty app name
+func splitAppPath(arg string) (string, string) {
+	name, path, found := strings.Cut(arg, ":")
+	if !found || strings.ContainsAny(name, "/\\") {
+		return "", arg
+	}
+	return name, path
+}
+
+func copyAppFiles(source string, destination string) error {
+	sourceApp, sourcePath := splitAppPath(source)
+	destinationApp, destinationPath := splitAppPath(destination)
+	if (sourceApp == "") == (destinationApp == "") {
+		return fmt.Errorf("exactly one of the source and destination has to be in an application, using the <name>:<path> format")
+	}
+
+	// transfers can be resumed, so they are not limited in time
+	ctx := context.Background()
+	if sourceApp != "" {
+		localPath, err := filepath.Abs(destinationPath)
+		if err != nil {
+			return fmt.Errorf("invalid destination '%s': %w", destinationPath, err)
+		}
+		resp, err := client.PullAppFiles(ctx, &pbApic.PullAppFilesRequest{Name: sourceApp, Path: sourcePath, LocalPath: localPath})
+		if err != nil {
+			return fmt.Errorf("failed to copy files from app '%s': %w", sourceApp, err)
+		}
+		fmt.Printf("Copied %d files (%d bytes) from app '%s'\n", resp.Files, resp.Bytes, sourceApp)
+		return nil
+	}
+
+	localPath, err := filepath.Abs(sourcePath)
+	if err != nil {
+		return fmt.Errorf("invalid source '%s': %w", sourcePath, err)
+	}
+	resp, err := client.PushAppFiles(ctx, &pbApic.PushAppFilesRequest{Name: destinationApp, LocalPath: localPath, Path: destinationPath})
+	if err != nil {
+		return fmt.Errorf("failed to copy files to app '%s': %w", destinationApp, err)
+	}
+	fmt.Printf("Copied %d files (%d bytes) to app '%s'\n", resp.Files, resp.Bytes, destinationApp)
+	return nil
+}
+
+// parsePortForward parses a [<address>:]<local port>:<remote port> argument. The local address defaults to localhost
+func parsePortForward(arg string) (string, int32, error) {
+	i := strings.LastIndex(arg, ":")
+	if i < 0 {
+		return "", 0, fmt.Errorf("'%s' doesn't use the [<address>:]<local port>:<remote port> format", arg)
+	}
+	local, remote := arg[:i], arg[i+1:]
+	remotePort, err := strconv.ParseUint(remote, 10, 16)
+	if err != nil || remotePort == 0 {
+		return "", 0, fmt.Errorf("remote port '%s' is invalid", remote)
+	}
+	if !strings.Contains(local, ":") {
+		local = net.JoinHostPort("127.0.0.1", local)
+	}
+	return local, int32(remotePort), nil
+}
+
+func portForwardApp(name string, ports string) error {
+	localAddress, remotePort, err := parsePortForward(ports)
+	if err != nil {
+		return err
+	}
+
+	stream, err := client.PortForward(context.Background(), &pbApic.PortForwardRequest{Name: name, LocalAddress: localAddress, RemotePort: remotePort})
+	if err != nil {
+		return fmt.Errorf("failed to forward port of app '%s': %w", name, err)
+	}
+
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to forward port of app '%s': %w", name, err)
+		}
+
+		switch event.State {
+		case "listening":
+			fmt.Printf("Forwarding %s to port %d of app '%s'\n", event.Address, remotePort, name)
+		case "failed":
+			fmt.Printf("Connection from %s failed: %s\n", event.Address, event.Error)
+		default:
+			fmt.Printf("Connection from %s %s\n", event.Address, event.State)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	apic "github.com/protosio/protos/apic/proto"
+	"github.com/urfave/cli/v2"
+)
+
+var cmdChangeset *cli.Command = &cli.Command{
+	Name:  "changeset",
+	Usage: "Stage changes to apps and DNS records, and review them before they reach the instances",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "open",
+			Usage: "Start staging changes. Use 'protos plan' to review them and 'protos apply' to apply them",
+			Action: func(c *cli.Context) error {
+				return openChangeset()
+			},
+		},
+		{
+			Name:  "status",
+			Usage: "Show if a changeset is open",
+			Action: func(c *cli.Context) error {
+				return changesetStatus()
+			},
+		},
+		{
+			Name:  "discard",
+			Usage: "Drop the staged changes",
+			Action: func(c *cli.Context) error {
+				return discardChangeset()
+			},
+		},
+	},
+}
+
+var cmdPlan *cli.Command = &cli.Command{
+	Name:  "plan",
+	Usage: "Show the changes staged in the open changeset",
+	Action: func(c *cli.Context) error {
+		return planChangeset()
+	},
+}
+
+var cmdApply *cli.Command = &cli.Command{
+	Name:  "apply",
+	Usage: "Apply the changes staged in the open changeset",
+	Action: func(c *cli.Context) error {
+		return applyChangeset()
+	},
+}
+
+//
+// Changeset methods
+//
+
+func openChangeset() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := client.OpenChangeset(ctx, &apic.OpenChangesetRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to open changeset: %w", err)
+	}
+	fmt.Println("Changeset opened. Changes to apps and DNS records are staged until they are applied")
+	fmt.Println("Instances can't be staged, so they can't be deployed, started, stopped or removed until the changeset is applied or discarded")
+	return nil
+}
+
+func changesetStatus() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	resp, err := client.GetChangeset(ctx, &apic.GetChangesetRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to retrieve changeset: %w", err)
+	}
+	if resp.Open {
+		fmt.Println("A changeset is open")
+	} else {
+		fmt.Println("No changeset is open")
+	}
+	return nil
+}
+
+func discardChangeset() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := client.DiscardChangeset(ctx, &apic.DiscardChangesetRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to discard changeset: %w", err)
+	}
+	fmt.Println("Changeset discarded")
+	return nil
+}
+
+// rowName returns the name of a changed row, or all its values for rows that don't have a name
+func rowName(row *apic.RowDiff) string {
+	values := row.To
+	if row.Type == "removed" {
+		values = row.From
+	}
+	if name, found := values["name"]; found {
+		return fmt.Sprintf("'%s'", name)
+	}
+	return formatRow(values)
+}
+
+// changedColumns returns the old and new values of the columns that changed in a modified row
+func changedColumns(row *apic.RowDiff) string {
+	columns := []string{}
+	for column, value := range row.To {
+		if row.From[column] != value {
+			columns = append(columns, column)
+		}
+	}
+	sort.Strings(columns)
+
+	changes := []string{}
+	for _, column := range columns {
+		changes = append(changes, fmt.Sprintf("%s: %s -> %s", column, row.From[column], row.To[column]))
+	}
+	return strings.Join(changes, ", ")
+}
+
+func planChangeset() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	resp, err := client.PlanChangeset(ctx, &apic.PlanChangesetRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to plan changeset: %w", err)
+	}
+
+	changes := 0
+	for _, table := range resp.Tables {
+		fmt.Printf("Changes to %s:\n", table.Table)
+		for _, row := range table.Rows {
+			switch row.Type {
+			case "added":
+				fmt.Printf("  + %s (%s)\n", rowName(row), formatRow(row.To))
+			case "removed":
+				fmt.Printf("  - %s\n", rowName(row))
+			default:
+				fmt.Printf("  ~ %s: %s\n", rowName(row), changedColumns(row))
+			}
+			changes++
+		}
+	}
+
+	if changes == 0 {
+		fmt.Println("No changes staged")
+		return nil
+	}
+	fmt.Printf("%d changes staged. Use 'protos apply' to apply them\n", changes)
+	return nil
+}
+
+func applyChangeset() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	resp, err := client.ApplyChangeset(ctx, &apic.ApplyChangesetRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to apply changeset: %w", err)
+	}
+	fmt.Printf("Changeset applied with commit '%s'\n", resp.Commit)
+	return nil
+}
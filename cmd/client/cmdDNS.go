@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	apic "github.com/protosio/protos/apic/proto"
+	"github.com/urfave/cli/v2"
+)
+
+var cmdDNS *cli.Command = &cli.Command{
+	Name:  "dns",
+	Usage: "Manage the internal DNS domain",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "record",
+			Usage: "Manage user defined DNS records",
+			Subcommands: []*cli.Command{
+				{
+					Name:  "ls",
+					Usage: "List DNS records",
+					Action: func(c *cli.Context) error {
+						return listDNSRecords()
+					},
+				},
+				{
+					Name:      "add",
+					ArgsUsage: "<name> <type> <value>",
+					Usage:     "Add a DNS record. Supported types are A, AAAA and CNAME. Names are relative to the internal domain, and can start with a * label (e.g. *.lab)",
+					Action: func(c *cli.Context) error {
+						name := c.Args().Get(0)
+						rrtype := c.Args().Get(1)
+						value := c.Args().Get(2)
+						if name == "" || rrtype == "" || value == "" {
+							cli.ShowSubcommandHelp(c)
+							os.Exit(1)
+						}
+						return addDNSRecord(name, rrtype, value)
+					},
+				},
+				{
+					Name:      "rm",
+					ArgsUsage: "<name>",
+					Usage:     "Remove the DNS records for a name",
+					Flags: []cli.Flag{
+						&cli.StringFlag{
+							Name:  "type",
+							Usage: "only remove the records of type `TYPE`",
+						},
+					},
+					Action: func(c *cli.Context) error {
+						name := c.Args().Get(0)
+						if name == "" {
+							cli.ShowSubcommandHelp(c)
+							os.Exit(1)
+						}
+						return removeDNSRecord(name, c.String("type"))
+					},
+				},
+			},
+		},
+	},
+}
+
+//
+// DNS methods
+//
+
+func listDNSRecords() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	resp, err := client.GetDNSRecords(ctx, &apic.GetDNSRecordsRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to list DNS records: %w", err)
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 0, 0, 2, ' ', 0)
+
+	defer w.Flush()
+
+	fmt.Fprintf(w, " %s\t%s\t%s\t", "Name", "Type", "Value")
+	fmt.Fprintf(w, "\n %s\t%s\t%s\t", "----", "----", "-----")
+	for _, record := range resp.Records {
+		name := record.Name + "." + resp.Domain
+		if record.Name == "@" {
+			name = resp.Domain
+		}
+		fmt.Fprintf(w, "\n %s\t%s\t%s\t", name, record.Type, record.Value)
+	}
+	fmt.Fprint(w, "\n")
+
+	return nil
+}
+
+func addDNSRecord(name string, rrtype string, value string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	resp, err := client.AddDNSRecord(ctx, &apic.AddDNSRecordRequest{Record: &apic.DNSRecord{Name: name, Type: rrtype, Value: value}})
+	if err != nil {
+		return fmt.Errorf("failed to add DNS record '%s': %w", name, err)
+	}
+
+	fmt.Printf("Added %s record '%s' with value '%s'\n", resp.Record.Type, resp.Record.Name, resp.Record.Value)
+	return nil
+}
+
+func removeDNSRecord(name string, rrtype string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := client.RemoveDNSRecord(ctx, &apic.RemoveDNSRecordRequest{Name: name, Type: rrtype})
+	if err != nil {
+		return fmt.Errorf("failed to remove DNS record '%s': %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/mdp/qrterminal/v3"
+	apic "github.com/protosio/protos/apic/proto"
+	"github.com/urfave/cli/v2"
+)
+
+var cmdDevice *cli.Command = &cli.Command{
+	Name:  "device",
+	Usage: "Manage user devices",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "ls",
+			Usage: "List devices",
+			Action: func(c *cli.Context) error {
+				return listDevices()
+			},
+		},
+		{
+			Name:  "add",
+			Usage: "Add a device that can't run Protos, like a phone or a router",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     "external",
+					Usage:    "Add an external device called `NAME`, which connects using a WireGuard config",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     "hub",
+					Usage:    "Use `INSTANCE` as the hub through which the external device reaches the network. The hub doesn't rotate its key while it has external devices",
+					Required: true,
+				},
+				&cli.BoolFlag{
+					Name:  "no-qr",
+					Usage: "Don't display the WireGuard config as a QR code",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return addExternalDevice(c.String("external"), c.String("hub"), !c.Bool("no-qr"))
+			},
+		},
+		{
+			Name:      "approve",
+			ArgsUsage: "<name>",
+			Usage:     "Approve a device, which allows it to read the secrets of the account",
+			Action: func(c *cli.Context) error {
+				name := c.Args().Get(0)
+				if name == "" {
+					cli.ShowSubcommandHelp(c)
+					os.Exit(1)
+				}
+				return approveDevice(name)
+			},
+		},
+	},
+}
+
+//
+// Device methods
+//
+
+func addExternalDevice(name string, hub string, showQR bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+	resp, err := client.AddExternalDevice(ctx, &apic.AddExternalDeviceRequest{Name: name, Hub: hub})
+	if err != nil {
+		return fmt.Errorf("could not add external device '%s': %w", name, err)
+	}
+
+	fmt.Printf("Added external device '%s' with network '%s'. Import the following WireGuard config on the device:\n\n", resp.Device.Name, resp.Device.Network)
+	fmt.Println(resp.WireguardConfig)
+	if showQR {
+		qrterminal.GenerateHalfBlock(resp.WireguardConfig, qrterminal.L, os.Stdout)
+	}
+	fmt.Println("The private key of the device is not stored, so keep this config safe.")
+	return nil
+}
+
+func approveDevice(name string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+	_, err := client.ApproveDevice(ctx, &apic.ApproveDeviceRequest{Name: name})
+	if err != nil {
+		return fmt.Errorf("could not approve device '%s': %w", name, err)
+	}
+	fmt.Printf("Approved device '%s'. It receives the data key once it's reachable\n", name)
+	return nil
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	apic "github.com/protosio/protos/apic/proto"
+	"github.com/urfave/cli/v2"
+)
+
+var cmdEvents *cli.Command = &cli.Command{
+	Name:  "events",
+	Usage: "Watch the state changes of the instances, apps and peers",
+	Flags: []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:  "type",
+			Usage: "only show events of type `TYPE` (db-head, app-status, instance-status, peer-join, peer-leave)",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		return watchEvents(c.StringSlice("type"))
+	},
+}
+
+//
+// Event methods
+//
+
+func watchEvents(types []string) error {
+	stream, err := client.WatchEvents(context.Background(), &apic.WatchEventsRequest{Types: types})
+	if err != nil {
+		return fmt.Errorf("failed to watch events: %w", err)
+	}
+
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to watch events: %w", err)
+		}
+
+		details := ""
+		switch event.Type {
+		case "db-head":
+			details = fmt.Sprintf("head: %s", event.Head)
+		case "app-status":
+			details = fmt.Sprintf("app: %s, instance: %s, status: %s", event.Name, event.Instance, event.Status)
+		case "instance-status":
+			details = fmt.Sprintf("instance: %s, status: %s", event.Name, event.Status)
+		default:
+			details = fmt.Sprintf("peer: %s", event.Name)
+		}
+		fmt.Printf("%s  %-16s %s (source: %s)\n", time.Unix(0, event.Time).Format(time.RFC3339), event.Type, details, event.Source)
+	}
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	apic "github.com/protosio/protos/apic/proto"
+	"github.com/urfave/cli/v2"
+)
+
+var cmdHistory *cli.Command = &cli.Command{
+	Name:  "history",
+	Usage: "Inspect and revert the changes made to the replicated state",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "ls",
+			Usage: "List the most recent changes",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:  "limit",
+					Value: 50,
+					Usage: "show at most `N` changes",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return listHistory(c.Int("limit"))
+			},
+		},
+		{
+			Name:      "show",
+			ArgsUsage: "<commit>",
+			Usage:     "Show a change and the tables it modified",
+			Action: func(c *cli.Context) error {
+				hash := c.Args().Get(0)
+				if hash == "" {
+					cli.ShowSubcommandHelp(c)
+					os.Exit(1)
+				}
+				return showHistoryCommit(hash)
+			},
+		},
+		{
+			Name:      "diff",
+			ArgsUsage: "<commit>",
+			Usage:     "Show the rows modified by a change",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "table",
+					Usage: "only show the rows of table `TABLE`",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				hash := c.Args().Get(0)
+				if hash == "" {
+					cli.ShowSubcommandHelp(c)
+					os.Exit(1)
+				}
+				return diffHistoryCommit(hash, c.String("table"))
+			},
+		},
+		{
+			Name:      "revert",
+			ArgsUsage: "<commit>",
+			Usage:     "Undo a change, using a new change",
+			Action: func(c *cli.Context) error {
+				hash := c.Args().Get(0)
+				if hash == "" {
+					cli.ShowSubcommandHelp(c)
+					os.Exit(1)
+				}
+				return revertHistoryCommit(hash)
+			},
+		},
+	},
+}
+
+//
+// History methods
+//
+
+func commitSigner(commit *apic.HistoryCommit) string {
+	if commit.Signer == "" {
+		return fmt.Sprintf("unverified (%s)", commit.Committer)
+	}
+	return fmt.Sprintf("%s (%s)", commit.Signer, commit.SignerType)
+}
+
+func listHistory(limit int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	resp, err := client.GetHistory(ctx, &apic.GetHistoryRequest{Limit: int32(limit)})
+	if err != nil {
+		return fmt.Errorf("failed to retrieve history: %w", err)
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 0, 0, 2, ' ', 0)
+
+	defer w.Flush()
+
+	fmt.Fprintf(w, " %s\t%s\t%s\t%s\t", "Commit", "Date", "Signer", "Message")
+	fmt.Fprintf(w, "\n %s\t%s\t%s\t%s\t", "------", "----", "------", "-------")
+	for _, commit := range resp.Commits {
+		fmt.Fprintf(w, "\n %s\t%s\t%s\t%s\t", commit.Hash, time.Unix(commit.Time, 0).Format(time.RFC3339), commitSigner(commit), commit.Message)
+	}
+	fmt.Fprint(w, "\n")
+	return nil
+}
+
+func showHistoryCommit(hash string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	resp, err := client.GetHistoryCommit(ctx, &apic.GetHistoryCommitRequest{Hash: hash})
+	if err != nil {
+		return fmt.Errorf("failed to retrieve commit '%s': %w", hash, err)
+	}
+
+	commit := resp.Commit
+	fmt.Printf("Commit: %s\n", commit.Hash)
+	fmt.Printf("Date: %s\n", time.Unix(commit.Time, 0).Format(time.RFC3339))
+	fmt.Printf("Signer: %s\n", commitSigner(commit))
+	fmt.Printf("Message: %s\n", commit.Message)
+	fmt.Println("Tables:")
+	for _, table := range resp.Tables {
+		changes := "data"
+		if table.SchemaChange {
+			changes = "schema"
+			if table.DataChange {
+				changes = "data and schema"
+			}
+		}
+		fmt.Printf("  %s: %s\n", table.Table, changes)
+	}
+	return nil
+}
+
+// formatRow returns the values of a row, ordered by column name
+func formatRow(values map[string]string) string {
+	columns := make([]string, 0, len(values))
+	for column := range values {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	row := ""
+	for i, column := range columns {
+		if i > 0 {
+			row += ", "
+		}
+		row += fmt.Sprintf("%s=%s", column, values[column])
+	}
+	return row
+}
+
+func diffHistoryCommit(hash string, table string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	resp, err := client.DiffHistoryCommit(ctx, &apic.DiffHistoryCommitRequest{Hash: hash, Table: table})
+	if err != nil {
+		return fmt.Errorf("failed to retrieve diff of commit '%s': %w", hash, err)
+	}
+
+	for _, tableDiff := range resp.Tables {
+		fmt.Printf("Table %s:\n", tableDiff.Table)
+		for _, row := range tableDiff.Rows {
+			switch row.Type {
+			case "added":
+				fmt.Printf("  + %s\n", formatRow(row.To))
+			case "removed":
+				fmt.Printf("  - %s\n", formatRow(row.From))
+			default:
+				fmt.Printf("  - %s\n", formatRow(row.From))
+				fmt.Printf("  + %s\n", formatRow(row.To))
+			}
+		}
+	}
+	return nil
+}
+
+func revertHistoryCommit(hash string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	resp, err := client.RevertHistoryCommit(ctx, &apic.RevertHistoryCommitRequest{Hash: hash})
+	if err != nil {
+		return fmt.Errorf("failed to revert commit '%s': %w", hash, err)
+	}
+	fmt.Printf("Reverted commit '%s' with commit '%s'\n", hash, resp.Commit)
+	return nil
+}
@@ -45,10 +45,18 @@ func listDevices() error {
 
 	defer w.Flush()
 
-	fmt.Fprintf(w, " %s\t%s\t%s\t%s\t", "Name", "Network", "WG Public Key", "ID")
-	fmt.Fprintf(w, "\n %s\t%s\t%s\t%s\t", "----", "-------", "-------------", "--")
+	fmt.Fprintf(w, " %s\t%s\t%s\t%s\t%s\t%s\t", "Name", "Network", "Public Key", "WG Public Key", "Hub", "Approved")
+	fmt.Fprintf(w, "\n %s\t%s\t%s\t%s\t%s\t%s\t", "----", "-------", "----------", "-------------", "---", "--------")
 	for _, device := range resp.Devices {
-		fmt.Fprintf(w, "\n %s\t%s\t%s\t%s\t", device.Name, device.Network, device.PublicKey, device.PublicKeyWireguard)
+		hub := "-"
+		if device.Hub != "" {
+			hub = device.Hub
+		}
+		approved := "-"
+		if device.Hub == "" {
+			approved = fmt.Sprintf("%t", device.Approved)
+		}
+		fmt.Fprintf(w, "\n %s\t%s\t%s\t%s\t%s\t%s\t", device.Name, device.Network, device.PublicKey, device.PublicKeyWireguard, hub, approved)
 	}
 	fmt.Fprint(w, "\n")
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
 	"time"
 
@@ -215,10 +216,14 @@ func listInstances() error {
 
 	defer w.Flush()
 
-	fmt.Fprintf(w, " %s\t%s\t%s\t%s\t%s\t%s\t%s\t", "Name", "Public IP", "Net", "Cloud", "VM ID", "Location", "Status")
-	fmt.Fprintf(w, "\n %s\t%s\t%s\t%s\t%s\t%s\t%s\t", "----", "---------", "---", "-----", "-----", "--------", "------")
+	fmt.Fprintf(w, " %s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t", "Name", "Public IP", "Net", "Cloud", "VM ID", "Location", "Version", "Status")
+	fmt.Fprintf(w, "\n %s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t", "----", "---------", "---", "-----", "-----", "--------", "-------", "------")
 	for _, instance := range resp.Instances {
-		fmt.Fprintf(w, "\n %s\t%s\t%s\t%s\t%s\t%s\t%s\t", instance.Name, instance.PublicIp, instance.Network, instance.CloudName, instance.VmId, instance.Location, instance.Status)
+		version := instance.ProtosVersion
+		if instance.UpgradeNeeded != "" {
+			version += " (upgrade needed)"
+		}
+		fmt.Fprintf(w, "\n %s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t", instance.Name, instance.PublicIp, instance.Network, instance.CloudName, instance.VmId, instance.Location, version, instance.Status)
 	}
 	fmt.Fprint(w, "\n")
 	return nil
@@ -238,6 +243,7 @@ func infoInstance(instanceName string) error {
 	fmt.Printf("Public Key: %s\n", instance.PublicKey)
 	fmt.Printf("Public Key (wireguard): %s\n", instance.PublicKeyWireguard)
 	fmt.Printf("Public IP: %s\n", instance.PublicIp)
+	fmt.Printf("P2P addresses: %s\n", strings.Join(instance.Multiaddrs, ", "))
 	fmt.Printf("Internal IP: %s\n", instance.InternalIp)
 	fmt.Printf("Network: %s\n", instance.Network)
 	fmt.Printf("Cloud type: %s\n", instance.CloudType)
@@ -245,10 +251,23 @@ func infoInstance(instanceName string) error {
 	fmt.Printf("Architecture: %s\n", instance.Architecture)
 	fmt.Printf("Location: %s\n", instance.Location)
 	fmt.Printf("Protosd version: %s\n", instance.ProtosVersion)
+	if instance.UpgradeNeeded != "" {
+		fmt.Printf("Upgrade needed: %s\n", instance.UpgradeNeeded)
+	}
 	fmt.Printf("Status: %s\n", instance.Status)
 	fmt.Println("Peers:")
-	for name, status := range instance.Peers {
-		fmt.Printf(" | %s - %s\n", name, status)
+	for _, peer := range instance.PeerStates {
+		details := peer.State
+		switch peer.State {
+		case "connected":
+			details += fmt.Sprintf(" since %s, rtt %s", time.Unix(peer.ConnectedSince, 0).Format(time.RFC3339), time.Duration(peer.Rtt)*time.Microsecond)
+		case "backoff":
+			details += fmt.Sprintf(", %d failed attempts, next at %s: %s", peer.ReconnectAttempts, time.Unix(peer.NextAttempt, 0).Format(time.RFC3339), peer.LastError)
+		}
+		if peer.Disconnects > 0 {
+			details += fmt.Sprintf(", %d disconnects", peer.Disconnects)
+		}
+		fmt.Printf(" | %s(%s) %s - %s\n", peer.Name, peer.Id, peer.Type, details)
 	}
 	return nil
 }
@@ -273,6 +292,9 @@ func deployInstance(instanceName string, cloudName string, cloudLocation string,
 	fmt.Printf("Architecture: %s\n", instance.Architecture)
 	fmt.Printf("Location: %s\n", instance.Location)
 	fmt.Printf("Protosd version: %s\n", instance.ProtosVersion)
+	if instance.UpgradeNeeded != "" {
+		fmt.Printf("Upgrade needed: %s\n", instance.UpgradeNeeded)
+	}
 	fmt.Printf("Status: %s\n", instance.Status)
 	return nil
 }
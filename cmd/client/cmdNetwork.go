@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	apic "github.com/protosio/protos/apic/proto"
+	"github.com/urfave/cli/v2"
+)
+
+var cmdNetwork *cli.Command = &cli.Command{
+	Name:  "network",
+	Usage: "Inspect the Protos mesh network",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "status",
+			Usage: "Display the status of the network connections to all peers",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "instance",
+					Usage: "Display the network status as seen by `INSTANCE`, instead of the local device",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return networkStatus(c.String("instance"))
+			},
+		},
+		{
+			Name:      "ping",
+			ArgsUsage: "<peer>",
+			Usage:     "Test the p2p and WireGuard paths towards a peer",
+			Action: func(c *cli.Context) error {
+				name := c.Args().Get(0)
+				if name == "" {
+					cli.ShowSubcommandHelp(c)
+					os.Exit(1)
+				}
+				return pingPeer(name)
+			},
+		},
+	},
+}
+
+//
+// Network methods
+//
+
+func formatRTT(rtt int64) string {
+	return (time.Duration(rtt) * time.Microsecond).Round(10 * time.Microsecond).String()
+}
+
+func formatHandshake(timestamp int64) string {
+	if timestamp == 0 {
+		return "never"
+	}
+	return fmt.Sprintf("%s ago", time.Since(time.Unix(timestamp, 0)).Round(time.Second).String())
+}
+
+func networkStatus(instance string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+	resp, err := client.GetNetworkStatus(ctx, &apic.GetNetworkStatusRequest{Instance: instance})
+	if err != nil {
+		return fmt.Errorf("could not retrieve network status: %w", err)
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 0, 0, 2, ' ', 0)
+
+	defer w.Flush()
+
+	fmt.Fprintf(w, " %s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t", "Peer", "P2P", "Transport", "RTT", "WG endpoint", "WG handshake", "WG rx", "WG tx")
+	fmt.Fprintf(w, "\n %s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t", "----", "---", "---------", "---", "-----------", "------------", "-----", "-----")
+	for _, peer := range resp.Peers {
+		p2pStatus := "disconnected"
+		if peer.Connected {
+			p2pStatus = "connected"
+		}
+		transport := "-"
+		if peer.Transport != "" {
+			transport = peer.Transport
+			if peer.Relayed {
+				transport = transport + " (relayed)"
+			}
+		}
+		rtt := "-"
+		if peer.PingError == "" {
+			rtt = formatRTT(peer.Rtt)
+		}
+		wgEndpoint, wgHandshake, wgRx, wgTx := "-", "-", "-", "-"
+		if peer.Wireguard != nil {
+			if peer.Wireguard.Endpoint != "" {
+				wgEndpoint = peer.Wireguard.Endpoint
+			}
+			wgHandshake = formatHandshake(peer.Wireguard.LastHandshake)
+			wgRx = fmt.Sprintf("%d", peer.Wireguard.RxBytes)
+			wgTx = fmt.Sprintf("%d", peer.Wireguard.TxBytes)
+		}
+		fmt.Fprintf(w, "\n %s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t", peer.Name, p2pStatus, transport, rtt, wgEndpoint, wgHandshake, wgRx, wgTx)
+	}
+	fmt.Fprint(w, "\n")
+	return nil
+}
+
+func pingPeer(name string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	resp, err := client.PingPeer(ctx, &apic.PingPeerRequest{Name: name})
+	if err != nil {
+		return fmt.Errorf("could not ping peer '%s': %w", name, err)
+	}
+
+	if resp.P2PError != "" {
+		fmt.Printf("p2p: failed (%s)\n", resp.P2PError)
+	} else {
+		fmt.Printf("p2p: %s\n", formatRTT(resp.P2PRtt))
+	}
+
+	if resp.WireguardError != "" {
+		fmt.Printf("WireGuard: failed (%s)\n", resp.WireguardError)
+	} else {
+		fmt.Printf("WireGuard: %s\n", formatRTT(resp.WireguardRtt))
+	}
+	return nil
+}
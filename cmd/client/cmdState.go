@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	survey "github.com/AlecAivazis/survey/v2"
+	apic "github.com/protosio/protos/apic/proto"
+	"github.com/protosio/protos/internal/state"
+	"github.com/urfave/cli/v2"
+)
+
+var cmdState *cli.Command = &cli.Command{
+	Name:  "state",
+	Usage: "Export the state of the account, or import it on a new device",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "export",
+			Usage: "Export users, devices, instances, apps, cloud providers and keys to a signed bundle",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    "output",
+					Aliases: []string{"o"},
+					Usage:   "File the bundle is written to",
+					Value:   "protos-state.json",
+				},
+				&cli.BoolFlag{
+					Name:  "encrypt",
+					Usage: "Encrypt the bundle using a passphrase",
+					Value: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return exportState(c.String("output"), c.Bool("encrypt"))
+			},
+		},
+		{
+			Name:      "import",
+			ArgsUsage: "<file>",
+			Usage:     "Rebuild the state of a fresh client from a bundle, and reconnect to the instances",
+			Action: func(c *cli.Context) error {
+				file := c.Args().Get(0)
+				if file == "" {
+					cli.ShowSubcommandHelp(c)
+					os.Exit(1)
+				}
+				return importState(file)
+			},
+		},
+	},
+}
+
+//
+// State methods
+//
+
+func exportState(output string, encrypt bool) error {
+	passphrase := ""
+	if encrypt {
+		passphraseQuestions := []*survey.Question{
+			{
+				Name:     "passphrase",
+				Prompt:   &survey.Password{Message: "Passphrase used to encrypt the bundle:"},
+				Validate: survey.Required,
+			},
+			{
+				Name:     "confirmation",
+				Prompt:   &survey.Password{Message: "Confirm passphrase:"},
+				Validate: survey.Required,
+			},
+		}
+		answers := struct {
+			Passphrase   string
+			Confirmation string
+		}{}
+		err := survey.Ask(passphraseQuestions, &answers)
+		if err != nil {
+			return err
+		}
+		if answers.Passphrase != answers.Confirmation {
+			return fmt.Errorf("passphrases don't match")
+		}
+		passphrase = answers.Passphrase
+	} else {
+		fmt.Println("WARNING: the bundle is not encrypted, and it contains the keys and cloud credentials of the account")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+	resp, err := client.ExportState(ctx, &apic.ExportStateRequest{Passphrase: passphrase})
+	if err != nil {
+		return fmt.Errorf("failed to export state: %w", err)
+	}
+
+	err = os.WriteFile(output, resp.Bundle, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to write state bundle: %w", err)
+	}
+	fmt.Printf("State exported to '%s'\n", output)
+	return nil
+}
+
+func importState(file string) error {
+	bundle, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("failed to read state bundle: %w", err)
+	}
+
+	encrypted, err := state.IsEncrypted(bundle)
+	if err != nil {
+		return err
+	}
+	passphrase := ""
+	if encrypted {
+		err = survey.AskOne(&survey.Password{Message: "Passphrase used to encrypt the bundle:"}, &passphrase, survey.WithValidator(survey.Required))
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+	_, err = client.ImportState(ctx, &apic.ImportStateRequest{Bundle: bundle, Passphrase: passphrase})
+	if err != nil {
+		return fmt.Errorf("failed to import state: %w", err)
+	}
+	fmt.Println("State imported. The instances are reconnected once the client finishes the initialization")
+	return nil
+}
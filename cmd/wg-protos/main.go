@@ -181,21 +181,33 @@ func configureLink(iface string, privateKey string, peerConfigs []string) error
 		return fmt.Errorf("failed to configure VPN interface '%s': %w", iface, err)
 	}
 
+	// the endpoints of the peers behind NAT are learned from their handshakes, since their mapped port isn't known
+	device, err := lnk.WGConfig()
+	if err != nil {
+		return fmt.Errorf("failed to configure VPN interface '%s': %w", iface, err)
+	}
+	observed := network.ObservedEndpoints(device)
+
 	keepAliveInterval := 25 * time.Second
 	peers := []wgtypes.PeerConfig{}
 	routes := []linkmgr.Route{}
 	for _, peerConfig := range peerConfigs {
-		_, pubkey, peerPublicIP, _, peerNet, err := parsePeerConfig(peerConfig)
+		_, pubkey, peerPublicIP, _, peerNets, err := parsePeerConfig(peerConfig)
 		if err != nil {
 			return fmt.Errorf("failed to configure VPN interface '%s': %w", iface, err)
 		}
 
-		routes = append(routes, linkmgr.Route{Dest: peerNet})
+		for _, peerNet := range peerNets {
+			routes = append(routes, linkmgr.Route{Dest: peerNet})
+		}
 		peerConf := wgtypes.PeerConfig{
-			PublicKey:                   pubkey,
-			PersistentKeepaliveInterval: &keepAliveInterval,
-			Endpoint:                    &net.UDPAddr{IP: peerPublicIP, Port: 10999},
-			AllowedIPs:                  []net.IPNet{peerNet},
+			PublicKey:  pubkey,
+			AllowedIPs: peerNets,
+		}
+		// peers that are relayed or that are behind NAT don't have an endpoint
+		if peerPublicIP != nil {
+			peerConf.PersistentKeepaliveInterval = &keepAliveInterval
+			peerConf.Endpoint = network.PeerEndpoint(peerPublicIP, observed[pubkey.String()])
 		}
 		peers = append(peers, peerConf)
 	}
@@ -251,32 +263,42 @@ func delDomain(domain string) error {
 	return nil
 }
 
-func parsePeerConfig(peerConfig string) (string, wgtypes.Key, net.IP, net.IP, net.IPNet, error) {
+// parsePeerConfig parses a peer config in the format 'name:publicKey:publicIP:internalIP:network[,network...]'. The
+// public IP can be empty for peers that don't have a known endpoint
+func parsePeerConfig(peerConfig string) (string, wgtypes.Key, net.IP, net.IP, []net.IPNet, error) {
 	parts := strings.Split(peerConfig, ":")
 	if len(parts) != 5 {
-		return "", wgtypes.Key{}, net.IP{}, net.IP{}, net.IPNet{}, fmt.Errorf("failed to parse the following peer config: '%s'", peerConfig)
+		return "", wgtypes.Key{}, nil, nil, nil, fmt.Errorf("failed to parse the following peer config: '%s'", peerConfig)
 	}
 
 	publicKey, err := base64.StdEncoding.DecodeString(parts[1])
 	if err != nil {
-		return "", wgtypes.Key{}, net.IP{}, net.IP{}, net.IPNet{}, fmt.Errorf("failed to decode public key in peer config '%s': %w", peerConfig, err)
+		return "", wgtypes.Key{}, nil, nil, nil, fmt.Errorf("failed to decode public key in peer config '%s': %w", peerConfig, err)
 	}
 
 	var wgPublicKey wgtypes.Key
 	copy(wgPublicKey[:], publicKey)
 
-	peerPublicIP := net.ParseIP(parts[2])
-	if peerPublicIP == nil {
-		return "", wgtypes.Key{}, net.IP{}, net.IP{}, net.IPNet{}, fmt.Errorf("failed to parse public IP in peer config '%s': %w", peerConfig, err)
+	var peerPublicIP net.IP
+	if parts[2] != "" {
+		peerPublicIP = net.ParseIP(parts[2])
+		if peerPublicIP == nil {
+			return "", wgtypes.Key{}, nil, nil, nil, fmt.Errorf("failed to parse public IP in peer config '%s'", peerConfig)
+		}
 	}
 	peerInternalIP := net.ParseIP(parts[3])
-	if peerPublicIP == nil {
-		return "", wgtypes.Key{}, net.IP{}, net.IP{}, net.IPNet{}, fmt.Errorf("failed to parse internal IP in peer config '%s': %w", peerConfig, err)
+	if peerInternalIP == nil {
+		return "", wgtypes.Key{}, nil, nil, nil, fmt.Errorf("failed to parse internal IP in peer config '%s'", peerConfig)
 	}
-	_, peerNet, err := net.ParseCIDR(parts[4])
-	if err != nil {
-		return "", wgtypes.Key{}, net.IP{}, net.IP{}, net.IPNet{}, fmt.Errorf("failed to parse network in peer config '%s': %w", peerConfig, err)
+
+	peerNets := []net.IPNet{}
+	for _, network := range strings.Split(parts[4], ",") {
+		_, peerNet, err := net.ParseCIDR(network)
+		if err != nil {
+			return "", wgtypes.Key{}, nil, nil, nil, fmt.Errorf("failed to parse network in peer config '%s': %w", peerConfig, err)
+		}
+		peerNets = append(peerNets, *peerNet)
 	}
 
-	return parts[0], wgPublicKey, peerPublicIP, peerInternalIP, *peerNet, nil
+	return parts[0], wgPublicKey, peerPublicIP, peerInternalIP, peerNets, nil
 }
@@ -3,6 +3,7 @@ package app
 import (
 	"fmt"
 	"net"
+	"strings"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -35,19 +36,52 @@ type Config struct {
 	Data        string
 }
 
+func portsToString(ports []util.Port) string {
+	portStrings := []string{}
+	for _, port := range ports {
+		portStrings = append(portStrings, port.String())
+	}
+	return strings.Join(portStrings, ",")
+}
+
+func portsFromString(portsString string) []util.Port {
+	ports := []util.Port{}
+	if portsString == "" {
+		return ports
+	}
+	for _, portString := range strings.Split(portsString, ",") {
+		port, err := util.ParsePort(portString)
+		if err != nil {
+			log.Warnf("Ignoring invalid app port: %s", err.Error())
+			continue
+		}
+		ports = append(ports, port)
+	}
+	return ports
+}
+
+func capabilitiesFromString(capabilitiesString string) []string {
+	if capabilitiesString == "" {
+		return []string{}
+	}
+	return strings.Split(capabilitiesString, ",")
+}
+
 // App represents the application state
 type App struct {
 	access *sync.Mutex
 	mgr    *Manager
 
 	// Public members
-	Name          string `json:"name"`
-	ID            string `json:"id"`
-	InstallerRef  string `json:"installer-ref"`
-	InstanceName  string `json:"instance-id"`
-	DesiredStatus string `json:"desired-status"`
-	IP            net.IP `json:"ip"`
-	Persistence   bool   `json:"persistence"`
+	Name          string      `json:"name"`
+	ID            string      `json:"id"`
+	InstallerRef  string      `json:"installer-ref"`
+	InstanceName  string      `json:"instance-id"`
+	DesiredStatus string      `json:"desired-status"`
+	IP            net.IP      `json:"ip"`
+	Persistence   bool        `json:"persistence"`
+	Ports         []util.Port `json:"ports"`
+	Capabilities  []string    `json:"capabilities"`
 }
 
 //
@@ -93,6 +127,16 @@ func (app *App) GetName() string {
 	return app.Name
 }
 
+// HasCapability checks if the application has been granted a capability
+func (app *App) HasCapability(name string) bool {
+	for _, capability := range app.Capabilities {
+		if capability == name {
+			return true
+		}
+	}
+	return false
+}
+
 // GetStatus returns the status of an application
 func (app *App) GetStatus() string {
 	cnt, err := app.mgr.runtime.GetSandbox(app.ID)
@@ -10,6 +10,7 @@ import (
 	"github.com/protosio/protos/internal/db"
 	"github.com/protosio/protos/internal/meta"
 	"github.com/protosio/protos/internal/runtime"
+	"github.com/protosio/protos/internal/util"
 
 	"github.com/pkg/errors"
 	"github.com/rs/xid"
@@ -21,13 +22,22 @@ const (
 	TypeProtosd = "protosd"
 )
 
+// StatusPublisher notifies the other instances about the status changes of the local apps
+type StatusPublisher interface {
+	PublishAppStatus(appID string, appName string, instanceName string, status string) error
+}
+
 // Manager keeps track of all the apps
 type Manager struct {
-	ptype   string
-	m       *meta.Meta
-	db      *db.DB
-	cm      *capability.Manager
-	runtime runtime.RuntimePlatform
+	ptype     string
+	m         *meta.Meta
+	db        *db.DB
+	cm        *capability.Manager
+	runtime   runtime.RuntimePlatform
+	publisher StatusPublisher
+
+	statusMu sync.Mutex
+	statuses map[string]string
 }
 
 //
@@ -37,57 +47,88 @@ type Manager struct {
 // CreateManager returns a Manager, which implements the *AppManager interface
 func CreateManager(ptype string, runtime runtime.RuntimePlatform, db *db.DB, meta *meta.Meta, cm *capability.Manager) *Manager {
 
-	manager := &Manager{ptype: ptype, db: db, m: meta, runtime: runtime, cm: cm}
+	manager := &Manager{ptype: ptype, db: db, m: meta, runtime: runtime, cm: cm, statuses: map[string]string{}}
 
 	return manager
 }
 
+// SetStatusPublisher sets the publisher used to announce the status changes of the local apps
+func (am *Manager) SetStatusPublisher(publisher StatusPublisher) {
+	am.publisher = publisher
+}
+
 //
 // Client methods
 //
 
 // Create takes an image and creates an application, without starting it
-func (am *Manager) Create(installer string, name string, instanceName string, instanceNetwork string, persistence bool, installerParams map[string]string) (*App, error) {
+func (am *Manager) Create(installer string, name string, instanceName string, instanceNetwork string, persistence bool, ports []util.Port, capabilities []string, installerParams map[string]string) (*App, error) {
 
 	var app *App
 	if name == "" || instanceName == "" {
 		return app, fmt.Errorf("application name, installer ID, installer version or instance ID cannot be empty")
 	}
 
-	apps, err := db.SelectMultiple(am.db, createInstanceQueryMapper(sq.New[db.APP](""), nil))
-	if err != nil {
-		return nil, fmt.Errorf("could not create application '%s': %w", name, err)
-	}
-
-	appIP, err := allocateIP(apps, instanceNetwork)
-	if err != nil {
-		return nil, fmt.Errorf("could not create application '%s': %w", name, err)
+	for _, capability := range capabilities {
+		if _, err := am.cm.GetByName(capability); err != nil {
+			return nil, fmt.Errorf("could not create application '%s': %w", name, err)
+		}
 	}
 
 	guid := xid.New()
 	log.Debugf("Creating application %s(%s), based on installer %s", guid.String(), name, installer)
-	app = &App{
-		access: &sync.Mutex{},
-		mgr:    am,
 
-		Name:          name,
-		ID:            guid.String(),
-		InstallerRef:  installer,
-		InstanceName:  instanceName,
-		IP:            appIP,
-		DesiredStatus: statusStopped,
-		Persistence:   persistence,
-	}
+	// the IP is allocated and saved in the same transaction, so concurrent creations can't get the same IP
+	err := am.db.Tx(fmt.Sprintf("Create application '%s'", name), func(tx *db.Tx) error {
+		apps, err := db.SelectMultiple(tx, createInstanceQueryMapper(sq.New[db.APP](""), nil))
+		if err != nil {
+			return fmt.Errorf("could not create application '%s': %w", name, err)
+		}
+
+		appIP, err := allocateIP(apps, instanceNetwork)
+		if err != nil {
+			return fmt.Errorf("could not create application '%s': %w", name, err)
+		}
+
+		app = &App{
+			access: &sync.Mutex{},
+			mgr:    am,
+
+			Name:          name,
+			ID:            guid.String(),
+			InstallerRef:  installer,
+			InstanceName:  instanceName,
+			IP:            appIP,
+			DesiredStatus: statusStopped,
+			Persistence:   persistence,
+			Ports:         ports,
+			Capabilities:  capabilities,
+		}
 
-	err = db.Insert(am.db, createAppInsertMapper(*app))
+		err = db.Insert(tx, createAppInsertMapper(*app))
+		if err != nil {
+			return errors.Wrapf(err, "Could not create application '%s'", name)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, errors.Wrapf(err, "Could not create application '%s'", name)
+		return nil, err
 	}
 
 	log.Debug("Created application ", name, "[", guid.String(), "]")
 	return app, nil
 }
 
+// Import saves an app restored from an export of the state, keeping its ID and IP. It runs as part of the transaction
+// of the import, before the app manager is created
+func Import(q db.Querier, app App) error {
+	err := db.Insert(q, createAppInsertMapper(app))
+	if err != nil {
+		return fmt.Errorf("could not import application '%s': %w", app.Name, err)
+	}
+	return nil
+}
+
 //
 // Instance methods
 //
@@ -97,21 +138,29 @@ func (am *Manager) GetByID(id string) (App, error) {
 	appModel := sq.New[db.APP]("")
 	app, err := db.SelectOne(am.db, createInstanceQueryMapper(appModel, []sq.Predicate{appModel.ID.EqString(id)}))
 	if err != nil {
-		return app, fmt.Errorf("failed to retrieve instance: %w", err)
+		return App{}, fmt.Errorf("could not find application '%s': %w", id, err)
 	}
 
-	return App{}, errors.Wrapf(err, "Could not find application '%s'", id)
+	app.mgr = am
+	app.access = &sync.Mutex{}
+	return app, nil
 }
 
 // Get returns a copy of an application based on its name
 func (am *Manager) Get(name string) (App, error) {
+	return am.get(am.db, name)
+}
+
+func (am *Manager) get(q db.Querier, name string) (App, error) {
 	appModel := sq.New[db.APP]("")
-	app, err := db.SelectOne(am.db, createInstanceQueryMapper(appModel, []sq.Predicate{appModel.ID.EqString(name)}))
+	app, err := db.SelectOne(q, createInstanceQueryMapper(appModel, []sq.Predicate{appModel.NAME.EqString(name)}))
 	if err != nil {
-		return app, fmt.Errorf("failed to retrieve instance: %w", err)
+		return App{}, fmt.Errorf("could not find application '%s': %w", name, err)
 	}
 
-	return App{}, fmt.Errorf("could not find application '%s'", name)
+	app.mgr = am
+	app.access = &sync.Mutex{}
+	return app, nil
 }
 
 // GetAll returns a copy of all the applications
@@ -124,6 +173,31 @@ func (am *Manager) GetAll() ([]App, error) {
 	return apps, nil
 }
 
+// GetRunning returns a copy of the applications that should be running. The status of the applications on the
+// current instance is checked in the runtime, while for the other instances only the desired status is available
+func (am *Manager) GetRunning() ([]App, error) {
+	apps, err := am.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	runningApps := []App{}
+	for _, app := range apps {
+		if app.DesiredStatus != statusRunning {
+			continue
+		}
+		if app.InstanceName == am.m.GetInstanceName() {
+			app.mgr = am
+			app.access = &sync.Mutex{}
+			if app.GetStatus() != statusRunning {
+				continue
+			}
+		}
+		runningApps = append(runningApps, app)
+	}
+	return runningApps, nil
+}
+
 // GetAll returns a copy of all the applications
 func (am *Manager) GetByIntance(instance string) ([]App, error) {
 	appModel := sq.New[db.APP]("")
@@ -172,6 +246,7 @@ func (am *Manager) Refresh() error {
 				}
 			}
 			log.Infof("App '%s' actual status: '%s'", app.Name, app.GetStatus())
+			am.publishStatus(app)
 		}
 	}
 
@@ -198,54 +273,51 @@ func (am *Manager) Refresh() error {
 	return nil
 }
 
-// Start sets the desired status of the app to stopped, which triggers the stopping of the app on the hosting instance
-func (am *Manager) Start(name string) error {
-	app, err := am.Get(name)
-	if err != nil {
-		return err
-	}
+// setDesiredStatus reads and updates the app in a transaction, so the update doesn't overwrite concurrent changes
+func (am *Manager) setDesiredStatus(name string, status string) error {
+	return am.db.Tx(fmt.Sprintf("Set desired status of application '%s' to '%s'", name, status), func(tx *db.Tx) error {
+		app, err := am.get(tx, name)
+		if err != nil {
+			return err
+		}
 
-	app.DesiredStatus = statusRunning
-	err = db.Update(am.db, createAppUpdateMapper(app))
-	if err != nil {
-		return fmt.Errorf("failed to set desired application status to '%s'(%s): %v", statusRunning, app.Name, err)
-	}
+		app.DesiredStatus = status
+		err = db.Update(tx, createAppUpdateMapper(app))
+		if err != nil {
+			return fmt.Errorf("failed to set desired application status to '%s'(%s): %v", status, app.Name, err)
+		}
+		return nil
+	})
+}
 
-	return nil
+// Start sets the desired status of the app to stopped, which triggers the stopping of the app on the hosting instance
+func (am *Manager) Start(name string) error {
+	return am.setDesiredStatus(name, statusRunning)
 }
 
 // Stop sets the desired status of the app to stopped, which triggers the stopping of the app on the hosting instance
 func (am *Manager) Stop(name string) error {
-	app, err := am.Get(name)
-	if err != nil {
-		return err
-	}
-
-	app.DesiredStatus = statusStopped
-	err = db.Update(am.db, createAppUpdateMapper(app))
-	if err != nil {
-		return fmt.Errorf("failed to set desired application status to '%s'(%s): %v", statusStopped, app.Name, err)
-	}
-	return nil
+	return am.setDesiredStatus(name, statusStopped)
 }
 
 // Remove removes an application based on the provided id
 func (am *Manager) Remove(name string) error {
-	app, err := am.Get(name)
-	if err != nil {
-		return errors.Wrapf(err, "Failed to remove application %s", name)
-	}
-
-	if app.DesiredStatus != statusStopped {
-		return fmt.Errorf("application '%s' should be stopped before being removed", name)
-	}
+	return am.db.Tx(fmt.Sprintf("Remove application '%s'", name), func(tx *db.Tx) error {
+		app, err := am.get(tx, name)
+		if err != nil {
+			return errors.Wrapf(err, "Failed to remove application %s", name)
+		}
 
-	err = db.Delete(am.db, createAppDeleteByNameQuery(name))
-	if err != nil {
-		return errors.Wrapf(err, "Failed to remove application %s", name)
-	}
+		if app.DesiredStatus != statusStopped {
+			return fmt.Errorf("application '%s' should be stopped before being removed", name)
+		}
 
-	return nil
+		err = db.Delete(tx, createAppDeleteByNameQuery(name))
+		if err != nil {
+			return errors.Wrapf(err, "Failed to remove application %s", name)
+		}
+		return nil
+	})
 }
 
 // GetLogs retrieves the logs for a specific app
@@ -278,6 +350,39 @@ func (am *Manager) GetStatus(name string) (string, error) {
 	return app.GetStatus(), nil
 }
 
+// GetVolumePath returns the path of the data volume of a local app
+func (am *Manager) GetVolumePath(name string) (string, error) {
+	app, err := am.Get(name)
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve volume for application '%s': %w", name, err)
+	}
+	if app.InstanceName != am.m.GetInstanceName() {
+		return "", fmt.Errorf("application '%s' runs on instance '%s'", name, app.InstanceName)
+	}
+	if !app.Persistence {
+		return "", fmt.Errorf("application '%s' doesn't have a data volume", name)
+	}
+
+	return am.runtime.GetVolumePath(app.ID)
+}
+
+// GetIP returns the IP of an application that runs on the local instance
+func (am *Manager) GetIP(name string) (net.IP, error) {
+	app, err := am.Get(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve IP for application '%s': %w", name, err)
+	}
+	if app.InstanceName != am.m.GetInstanceName() {
+		return nil, fmt.Errorf("application '%s' runs on instance '%s'", name, app.InstanceName)
+	}
+	ip := app.GetIP()
+	if ip == nil {
+		return nil, fmt.Errorf("application '%s' doesn't have an IP", name)
+	}
+
+	return ip, nil
+}
+
 func allocateIP(apps []App, networkStr string) (net.IP, error) {
 
 	_, network, err := net.ParseCIDR(networkStr)
@@ -318,3 +423,28 @@ func incIP(ip net.IP) {
 		}
 	}
 }
+
+//
+// Private methods
+//
+
+// publishStatus announces the status of a local app if it changed since the last refresh
+func (am *Manager) publishStatus(app App) {
+	if am.publisher == nil {
+		return
+	}
+
+	status := app.GetStatus()
+	am.statusMu.Lock()
+	previous, found := am.statuses[app.ID]
+	am.statuses[app.ID] = status
+	am.statusMu.Unlock()
+	if found && previous == status {
+		return
+	}
+
+	err := am.publisher.PublishAppStatus(app.ID, app.Name, app.InstanceName, status)
+	if err != nil {
+		log.Errorf("Failed to publish status of app '%s': %s", app.Name, err.Error())
+	}
+}
@@ -2,6 +2,7 @@ package app
 
 import (
 	"net"
+	"strings"
 
 	"github.com/bokwoon95/sq"
 	"github.com/protosio/protos/internal/db"
@@ -18,6 +19,8 @@ func createAppInsertMapper(app App) func() (sq.Table, func(*sq.Column)) {
 			col.SetString(a.DESIRED_STATUS, app.DesiredStatus)
 			col.SetString(a.IP, app.IP.String())
 			col.SetBool(a.PERSISTENCE, app.Persistence)
+			col.SetString(a.PORTS, portsToString(app.Ports))
+			col.SetString(a.CAPABILITIES, strings.Join(app.Capabilities, ","))
 		}
 	}
 }
@@ -33,6 +36,8 @@ func createAppUpdateMapper(app App) func() (sq.Table, func(*sq.Column), []sq.Pre
 			col.SetString(a.DESIRED_STATUS, app.DesiredStatus)
 			col.SetString(a.IP, app.IP.String())
 			col.SetBool(a.PERSISTENCE, app.Persistence)
+			col.SetString(a.PORTS, portsToString(app.Ports))
+			col.SetString(a.CAPABILITIES, strings.Join(app.Capabilities, ","))
 		}, predicates
 	}
 }
@@ -49,6 +54,8 @@ func createInstanceQueryMapper(a db.APP, predicates []sq.Predicate) func() (sq.T
 				DesiredStatus: row.StringField(a.DESIRED_STATUS),
 				IP:            net.ParseIP(row.StringField(a.IP)),
 				Persistence:   row.BoolField(a.PERSISTENCE),
+				Ports:         portsFromString(row.StringField(a.PORTS)),
+				Capabilities:  capabilitiesFromString(row.StringField(a.CAPABILITIES)),
 			}
 		}
 		return a, mapper, predicates
@@ -13,10 +13,9 @@ func createUserInsertMapper(user User) func() (sq.Table, func(*sq.Column)) {
 	return func() (sq.Table, func(*sq.Column)) {
 		u := sq.New[db.USER]("")
 		return u, func(col *sq.Column) {
-			col.SetString(u.USERNAME, user.Name)
+			col.SetString(u.USERNAME, user.Username)
 			col.SetString(u.NAME, user.Name)
 			col.SetBool(u.IS_DISABLED, user.IsDisabled)
-			col.SetArray(u.DEVICES, user.Devices)
 		}
 	}
 }
@@ -26,10 +25,9 @@ func createUserUpdateMapper(user User) func() (sq.Table, func(*sq.Column), []sq.
 		u := sq.New[db.USER]("")
 		predicates := []sq.Predicate{u.USERNAME.EqString(user.Username)}
 		return u, func(col *sq.Column) {
-			col.SetString(u.USERNAME, user.Name)
+			col.SetString(u.USERNAME, user.Username)
 			col.SetString(u.NAME, user.Name)
 			col.SetBool(u.IS_DISABLED, user.IsDisabled)
-			col.SetArray(u.DEVICES, user.Devices)
 		}, predicates
 	}
 }
@@ -67,6 +65,10 @@ func createUserDeviceInsertMapper(device UserDevice) func() (sq.Table, func(*sq.
 			col.SetString(d.PUBLIC_KEY, device.PublicKey)
 			col.SetString(d.NETWORK, device.Network)
 			col.SetString(d.NAME, device.Name)
+			col.SetString(d.HUB, device.Hub)
+			col.SetString(d.APPROVED_KEY, device.ApprovedKey)
+			col.SetString(d.APPROVED_BY, device.ApprovedBy)
+			col.SetString(d.APPROVAL, device.Approval)
 		}
 	}
 }
@@ -80,6 +82,10 @@ func createUserDeviceUpdateMapper(device UserDevice) func() (sq.Table, func(*sq.
 			col.SetString(d.PUBLIC_KEY, device.PublicKey)
 			col.SetString(d.NETWORK, device.Network)
 			col.SetString(d.NAME, device.Name)
+			col.SetString(d.HUB, device.Hub)
+			col.SetString(d.APPROVED_KEY, device.ApprovedKey)
+			col.SetString(d.APPROVED_BY, device.ApprovedBy)
+			col.SetString(d.APPROVAL, device.Approval)
 		}, predicates
 	}
 }
@@ -89,10 +95,14 @@ func createUserDeviceQueryMapper(ud db.USER_DEVICE, predicates []sq.Predicate) f
 		mapper := func(row *sq.Row) UserDevice {
 
 			return UserDevice{
-				MachineID: row.StringField(ud.ID),
-				PublicKey: row.StringField(ud.PUBLIC_KEY),
-				Network:   row.StringField(ud.NETWORK),
-				Name:      row.StringField(ud.NAME),
+				MachineID:   row.StringField(ud.ID),
+				PublicKey:   row.StringField(ud.PUBLIC_KEY),
+				Network:     row.StringField(ud.NETWORK),
+				Name:        row.StringField(ud.NAME),
+				Hub:         row.StringField(ud.HUB),
+				ApprovedKey: row.StringField(ud.APPROVED_KEY),
+				ApprovedBy:  row.StringField(ud.APPROVED_BY),
+				Approval:    row.StringField(ud.APPROVAL),
 			}
 		}
 		return ud, mapper, predicates
@@ -9,6 +9,7 @@ import (
 
 	"github.com/protosio/protos/internal/capability"
 	"github.com/protosio/protos/internal/db"
+	"github.com/protosio/protos/internal/p2p"
 	"github.com/protosio/protos/internal/pcrypto"
 	"github.com/protosio/protos/internal/util"
 
@@ -33,6 +34,42 @@ type UserDevice struct {
 	PublicKey string `json:"publickey" validate:"base64"`   // ed25519 public key
 	Network   string `json:"network" validate:"cidrv4"`     // CIDR notation
 	MachineID string `json:"machineid" validate:"required"` // ID that uniquely identifies a machine
+	Hub       string `json:"hub"`                           // instance used by external devices to reach the network
+
+	// devices are approved by a device that holds the data key, and only approved devices receive the data key
+	ApprovedKey string `json:"approvedkey"` // public key of the device at the time it was approved
+	ApprovedBy  string `json:"approvedby"`  // public key of the approving device
+	Approval    string `json:"approval"`    // signature created by the approving device
+}
+
+// approvalMessage returns the content that is signed when a device is approved
+func approvalMessage(machineID string, publicKey string) []byte {
+	return []byte(fmt.Sprintf("protos-device-approval:%s:%s", machineID, publicKey))
+}
+
+// Approve marks the device as approved by the provided key, which belongs to the device of the user that approves it
+func (ud *UserDevice) Approve(approver *pcrypto.Key) {
+	ud.ApprovedKey = ud.PublicKey
+	ud.ApprovedBy = approver.PublicString()
+	ud.Approval = approver.SignMessage(approvalMessage(ud.MachineID, ud.ApprovedKey))
+}
+
+// VerifyApproval checks the signature of the approval. Whether the approving device is trusted is up to the caller
+func (ud *UserDevice) VerifyApproval() error {
+	if ud.Approval == "" {
+		return fmt.Errorf("device '%s' is not approved", ud.Name)
+	}
+	err := pcrypto.VerifySignature(ud.ApprovedBy, ud.Approval, approvalMessage(ud.MachineID, ud.ApprovedKey))
+	if err != nil {
+		return fmt.Errorf("invalid approval for device '%s': %w", ud.Name, err)
+	}
+	return nil
+}
+
+// IsExternal returns true for devices that can't run Protos (phones, routers etc), and which connect to the network
+// via a hub instance using a plain WireGuard config
+func (ud *UserDevice) IsExternal() bool {
+	return ud.Hub != ""
 }
 
 // User represents a Protos user
@@ -46,16 +83,30 @@ type User struct {
 	Devices    []UserDevice `json:"devices"`
 }
 
-func getUser(username string, dbi *db.DB) (User, error) {
+func getUser(username string, dbi db.Querier) (User, error) {
 	userModel := sq.New[db.USER]("")
 	user, err := db.SelectOne(dbi, createUserQueryMapper(userModel, []sq.Predicate{userModel.USERNAME.EqString(username)}))
 	if err != nil {
 		return user, fmt.Errorf("failed to retrieve user: %w", err)
 	}
 
+	user.Devices, err = getDevices(dbi)
+	if err != nil {
+		return user, fmt.Errorf("failed to retrieve user: %w", err)
+	}
+
 	return user, nil
 }
 
+// getDevices returns all the user devices. At the moment there is only one user, so all devices belong to it
+func getDevices(dbi db.Querier) ([]UserDevice, error) {
+	devices, err := db.SelectMultiple(dbi, createUserDeviceQueryMapper(sq.New[db.USER_DEVICE](""), nil))
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve user devices: %w", err)
+	}
+	return devices, nil
+}
+
 //
 // UserDevice methods
 //
@@ -68,10 +119,22 @@ func (ud *UserDevice) GetPublicIP() string {
 	return ""
 }
 
+func (ud *UserDevice) GetMultiaddrs() []string {
+	return nil
+}
+
+func (ud *UserDevice) GetMachineID() string {
+	return ud.MachineID
+}
+
 func (ud *UserDevice) GetName() string {
 	return ud.Name
 }
 
+func (ud *UserDevice) GetType() string {
+	return p2p.MachineTypeDevice
+}
+
 //
 // User instance methods
 //
@@ -83,7 +146,21 @@ func (user *User) GetUsername() string {
 
 // Save saves the User struct to the database. The username is used as an unique key
 func (user *User) Save() error {
-	err := db.Insert(user.parent.db, createUserInsertMapper(*user))
+	return user.parent.db.Tx(fmt.Sprintf("Save user '%s'", user.Username), user.save)
+}
+
+// save inserts or updates the user in a transaction, so that the check and the write see the same state
+func (user *User) save(tx *db.Tx) error {
+	_, err := getUser(user.Username, tx)
+	if err == nil {
+		err = db.Update(tx, createUserUpdateMapper(*user))
+		if err != nil {
+			return errors.Wrapf(err, "Could not update user '%s'", user.Username)
+		}
+		return nil
+	}
+
+	err = db.Insert(tx, createUserInsertMapper(*user))
 	if err != nil {
 		return errors.Wrapf(err, "Could not insert user '%s'", user.Username)
 	}
@@ -107,14 +184,80 @@ func (user *User) GetInfo() UserInfo {
 
 // AddDevice adds a device to the user
 func (user *User) AddDevice(id string, name string, publicKey string, network string) error {
-	device := UserDevice{
+	return user.addDevice(UserDevice{
+		MachineID: id,
+		Name:      name,
+		PublicKey: publicKey,
+		Network:   network,
+	})
+}
+
+// AddExternalDevice adds a device that reaches the network via the provided hub instance
+func (user *User) AddExternalDevice(id string, name string, publicKey string, network string, hub string) error {
+	return user.addDevice(UserDevice{
 		MachineID: id,
 		Name:      name,
 		PublicKey: publicKey,
 		Network:   network,
+		Hub:       hub,
+	})
+}
+
+func (user *User) addDevice(device UserDevice) error {
+	return user.insertDevice(user.parent.db, device)
+}
+
+func (user *User) insertDevice(q db.Querier, device UserDevice) error {
+	for _, existingDevice := range user.Devices {
+		if existingDevice.Name == device.Name {
+			return fmt.Errorf("could not add device '%s': a device with the same name already exists", device.Name)
+		}
+	}
+
+	err := db.Insert(q, createUserDeviceInsertMapper(device))
+	if err != nil {
+		return fmt.Errorf("could not add device '%s': %w", device.Name, err)
 	}
 	user.Devices = append(user.Devices, device)
-	return user.Save()
+	return nil
+}
+
+// SetDevicePublicKey updates the public key of a device, which happens when the device rotates its key
+func (user *User) SetDevicePublicKey(id string, publicKey string) error {
+	for i, device := range user.Devices {
+		if device.MachineID != id {
+			continue
+		}
+		device.PublicKey = publicKey
+		err := db.Update(user.parent.db, createUserDeviceUpdateMapper(device))
+		if err != nil {
+			return fmt.Errorf("could not update public key for device '%s': %w", device.Name, err)
+		}
+		user.Devices[i] = device
+		return nil
+	}
+	return fmt.Errorf("could not find device with id '%s'", id)
+}
+
+// ApproveDevice approves a device of the user using the key of the current device, which allows the device to
+// receive the data key
+func (user *User) ApproveDevice(name string, approver *pcrypto.Key) error {
+	for i, device := range user.Devices {
+		if device.Name != name {
+			continue
+		}
+		if device.IsExternal() {
+			return fmt.Errorf("device '%s' is an external device, which doesn't need approval", name)
+		}
+		device.Approve(approver)
+		err := db.Update(user.parent.db, createUserDeviceUpdateMapper(device))
+		if err != nil {
+			return fmt.Errorf("could not approve device '%s': %w", device.Name, err)
+		}
+		user.Devices[i] = device
+		return nil
+	}
+	return fmt.Errorf("could not find device '%s'", name)
 }
 
 // GetDevices returns the devices that belong to a user
@@ -178,9 +321,23 @@ func CreateUserManager(db *db.DB, sm *pcrypto.Manager, cm *capability.Manager, c
 	return &UserManager{db: db, sm: sm, cm: cm}
 }
 
-// CreateUser creates and returns a user
-func (um *UserManager) CreateUser(username string, name string, isadmin bool) (*User, error) {
+// CreateUser creates and returns a user, together with its devices. The user and the devices are saved in a single
+// commit, so a failure doesn't leave behind a user without devices
+func (um *UserManager) CreateUser(username string, name string, isadmin bool, devices ...UserDevice) (*User, error) {
+	var user *User
+	err := um.db.Tx(fmt.Sprintf("Create user '%s'", username), func(tx *db.Tx) error {
+		var err error
+		user, err = um.ImportUser(tx, username, name, isadmin, devices...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
 
+// ImportUser creates a user and its devices as part of a larger transaction, like the import of the state
+func (um *UserManager) ImportUser(tx *db.Tx, username string, name string, isadmin bool, devices ...UserDevice) (*User, error) {
 	user := User{
 		parent:     um,
 		Username:   username,
@@ -189,7 +346,17 @@ func (um *UserManager) CreateUser(username string, name string, isadmin bool) (*
 		Devices:    []UserDevice{},
 	}
 
-	return &user, user.Save()
+	err := user.save(tx)
+	if err != nil {
+		return nil, err
+	}
+	for _, device := range devices {
+		err := user.insertDevice(tx, device)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &user, nil
 }
 
 // GetUser returns a user based on the username
@@ -214,7 +381,14 @@ func (um *UserManager) GetAdmin() (User, error) {
 		return User{}, fmt.Errorf("could not find admin user")
 	}
 
-	return users[0], nil
+	admin := users[0]
+	admin.parent = um
+	admin.Devices, err = getDevices(um.db)
+	if err != nil {
+		return User{}, fmt.Errorf("could not retrieve admin user: %w", err)
+	}
+
+	return admin, nil
 }
 
 // SetParent returns sets the parent (user manager) for a given user
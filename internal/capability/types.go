@@ -21,6 +21,12 @@ func (cm *Manager) createTree(root *Capability) {
 	// PublicDNS capability tells the platform to create a public dns record using the applications name
 	PublicDNS := cm.New("PublicDNS")
 
+	// Peer capabilities are held by the machines that call the p2p services. Devices can call all the methods
+	// available to instances, which in turn can call the methods available while an instance is initialised
+	PeerDevice := cm.New("PeerDevice")
+	PeerInstance := cm.New("PeerInstance")
+	PeerInit := cm.New("PeerInit")
+
 	RegisterResourceProvider.SetParent(ResourceProvider)
 	DeregisterResourceProvider.SetParent(ResourceProvider)
 	GetProviderResources.SetParent(ResourceProvider)
@@ -34,4 +40,8 @@ func (cm *Manager) createTree(root *Capability) {
 	AuthUser.SetParent(root)
 
 	PublicDNS.SetParent(root)
+
+	PeerInit.SetParent(PeerInstance)
+	PeerInstance.SetParent(PeerDevice)
+	PeerDevice.SetParent(root)
 }
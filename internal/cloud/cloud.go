@@ -7,6 +7,7 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"slices"
 	"strings"
 	"sync"
 	"syscall"
@@ -82,7 +83,7 @@ func (cm *Manager) SupportedProviders() []string {
 // GetProvider returns a cloud provider instance from the db
 func (cm *Manager) GetProvider(name string) (CloudProvider, error) {
 	cpModel := sq.New[db.CLOUD_PROVIDER]("")
-	cpi, err := db.SelectOne(cm.db, createCloudProviderQueryMapper(cpModel, []sq.Predicate{cpModel.NAME.EqString(name)}))
+	cpi, err := db.SelectOne(cm.db, createCloudProviderQueryMapper(cpModel, []sq.Predicate{cpModel.NAME.EqString(name)}, cm))
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve cloud provider '%s': %w", name, err)
 	}
@@ -90,33 +91,39 @@ func (cm *Manager) GetProvider(name string) (CloudProvider, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve cloud provider '%s': %w", name, err)
 	}
-	return cp, fmt.Errorf("could not find cloud provider '%s'", name)
+	return cp, nil
 }
 
-// DeleteProvider deletes a cloud provider from the db
+// DeleteProvider deletes a cloud provider from the db. Providers that are still used by instances can't be deleted
 func (cm *Manager) DeleteProvider(name string) error {
-	cld, err := cm.GetProvider(name)
-	if err != nil {
-		return err
-	}
-
-	providerInfo, ok := cld.(*ProviderInfo)
-	if !ok {
-		panic("Failed type assertion in delete provider")
-	}
+	return cm.db.Tx(fmt.Sprintf("Delete cloud provider '%s'", name), func(tx *db.Tx) error {
+		cpModel := sq.New[db.CLOUD_PROVIDER]("")
+		_, err := db.SelectOne(tx, createCloudProviderQueryMapper(cpModel, []sq.Predicate{cpModel.NAME.EqString(name)}, cm))
+		if err != nil {
+			return fmt.Errorf("failed to retrieve cloud provider '%s': %w", name, err)
+		}
 
-	err = db.Delete(cm.db, createInstanceDeleteByNameQuery(providerInfo.Name))
-	if err != nil {
-		return fmt.Errorf("failed to delete instance '%s': %w", name, err)
-	}
+		instanceModel := sq.New[db.INSTANCE]("")
+		instances, err := db.SelectMultiple(tx, createInstanceQueryMapper(instanceModel, []sq.Predicate{instanceModel.CLOUD_NAME.EqString(name)}, cm.sm))
+		if err != nil {
+			return fmt.Errorf("failed to retrieve instances of cloud provider '%s': %w", name, err)
+		}
+		if len(instances) > 0 {
+			return fmt.Errorf("cloud provider '%s' is used by %d instances, which have to be deleted first", name, len(instances))
+		}
 
-	return nil
+		err = db.Delete(tx, createCloudProviderDeleteByNameQuery(name))
+		if err != nil {
+			return fmt.Errorf("failed to delete cloud provider '%s': %w", name, err)
+		}
+		return nil
+	})
 }
 
 // GetProviders returns all the cloud providers from the db
 func (cm *Manager) GetProviders() ([]CloudProvider, error) {
 	cloudProviders := []CloudProvider{}
-	clouds, err := db.SelectMultiple(cm.db, createCloudProviderQueryMapper(sq.New[db.CLOUD_PROVIDER](""), nil))
+	clouds, err := db.SelectMultiple(cm.db, createCloudProviderQueryMapper(sq.New[db.CLOUD_PROVIDER](""), nil, cm))
 	if err != nil {
 		return cloudProviders, fmt.Errorf("failed to retrieve cloud providers: %w", err)
 	}
@@ -136,6 +143,26 @@ func (cm *Manager) GetProviders() ([]CloudProvider, error) {
 // Instance related methods
 //
 
+// saveNewInstance saves a new instance in a single commit. The name and network of the instance are checked in the
+// same transaction, since they could have been taken while the instance was deployed
+func (cm *Manager) saveNewInstance(message string, instance InstanceInfo) error {
+	return cm.db.Tx(message, func(tx *db.Tx) error {
+		instances, err := db.SelectMultiple(tx, createInstanceQueryMapper(sq.New[db.INSTANCE](""), nil, cm.sm))
+		if err != nil {
+			return fmt.Errorf("failed to retrieve instances: %w", err)
+		}
+		for _, existing := range instances {
+			if existing.Name == instance.Name {
+				return fmt.Errorf("an instance named '%s' already exists", instance.Name)
+			}
+			if existing.Network == instance.Network {
+				return fmt.Errorf("network '%s' is already used by instance '%s'", instance.Network, existing.Name)
+			}
+		}
+		return cm.insertInstance(tx, instance)
+	})
+}
+
 // DeployInstance deploys an instance on the provided cloud
 func (cm *Manager) DeployInstance(instanceName string, cloudName string, cloudLocation string, release release.Release, machineType string) (InstanceInfo, error) {
 	usr, err := cm.um.GetAdmin()
@@ -196,121 +223,177 @@ func (cm *Manager) DeployInstance(instanceName string, cloudName string, cloudLo
 		return InstanceInfo{}, fmt.Errorf("failed to deploy Protos instance: %w", err)
 	}
 
-	// deploy a protos instance
-	log.Infof("Deploying instance '%s' of type '%s', using Protos version '%s' (image id '%s')", instanceName, machineType, release.Version, imageID)
-	vmID, err := provider.NewInstance(instanceName, imageID, instanceSSHKey.AuthorizedKey(), machineType, cloudLocation)
-	if err != nil {
-		return InstanceInfo{}, fmt.Errorf("failed to deploy Protos instance: %w", err)
-	}
-	log.Infof("Instance with ID '%s' deployed", vmID)
-
-	// get instance info
-	instanceInfo, err := provider.GetInstanceInfo(vmID, cloudLocation)
+	thisDevice, err := usr.GetCurrentDevice()
 	if err != nil {
-		return InstanceInfo{}, fmt.Errorf("failed to get Protos instance info: %w", err)
+		return InstanceInfo{}, fmt.Errorf("failed to get current device : %w", err)
 	}
 
 	// allocate network
 	instances, err := cm.GetInstances()
 	if err != nil {
-		return InstanceInfo{}, fmt.Errorf("failed to allocate network for instance '%s': %w", instanceInfo.Name, err)
+		return InstanceInfo{}, fmt.Errorf("failed to allocate network for instance '%s': %w", instanceName, err)
+	}
+	network, err := allocateNetwork(instances, usr.GetDevices())
+	if err != nil {
+		return InstanceInfo{}, fmt.Errorf("failed to allocate network for instance '%s': %w", instanceName, err)
 	}
 
-	userDevices := usr.GetDevices()
-	network, err := allocateNetwork(instances, userDevices)
+	// the name and the network are reserved before any cloud resources are created, and the SSH key is saved so the
+	// VM can still be reached if the deployment is interrupted
+	instanceInfo := InstanceInfo{
+		VMID:          reservedVMIDPrefix + instanceName,
+		Name:          instanceName,
+		SSHKeySeed:    base64.StdEncoding.EncodeToString(instanceSSHKey.Seed()),
+		CloudType:     provider.TypeStr(),
+		CloudName:     cloudName,
+		Location:      cloudLocation,
+		Network:       network.String(),
+		ProtosVersion: release.Version,
+		Status:        ServerStateChanging,
+	}
+	err = cm.saveNewInstance(fmt.Sprintf("Reserve instance '%s'", instanceName), instanceInfo)
 	if err != nil {
-		return InstanceInfo{}, fmt.Errorf("failed to allocate network for instance '%s': %w", instanceInfo.Name, err)
+		return InstanceInfo{}, fmt.Errorf("failed to reserve instance '%s': %w", instanceName, err)
 	}
 
-	thisDevice, err := usr.GetCurrentDevice()
+	instanceInfo, err = cm.deployReservedInstance(provider, instanceInfo, imageID, machineType, instanceSSHKey, thisDevice)
 	if err != nil {
-		return InstanceInfo{}, fmt.Errorf("failed to get current device : %w", err)
+		cm.cleanupFailedDeployment(provider, instanceInfo)
+		return InstanceInfo{}, err
 	}
 
-	// save instance information
-	instanceInfo.SSHKeySeed = base64.StdEncoding.EncodeToString(instanceSSHKey.Seed())
-	instanceInfo.ProtosVersion = release.Version
-	instanceInfo.Network = network.String()
+	log.Infof("Instance '%s' at '%s' is ready", instanceName, instanceInfo.PublicIP)
+
+	return instanceInfo, nil
+}
+
+// deployReservedInstance creates the VM and the data volume of a reserved instance, and initializes Protos on it. The
+// returned instance holds the IDs of the cloud resources that were created, even if the deployment failed
+func (cm *Manager) deployReservedInstance(provider CloudProvider, instanceInfo InstanceInfo, imageID string, machineType string, instanceSSHKey *pcrypto.Key, thisDevice auth.UserDevice) (InstanceInfo, error) {
+	instanceName := instanceInfo.Name
+	cloudLocation := instanceInfo.Location
+
+	// deploy a protos instance
+	log.Infof("Deploying instance '%s' of type '%s', using Protos version '%s' (image id '%s')", instanceName, machineType, instanceInfo.ProtosVersion, imageID)
+	vmID, err := provider.NewInstance(instanceName, imageID, instanceSSHKey.AuthorizedKey(), machineType, cloudLocation)
+	if err != nil {
+		return instanceInfo, fmt.Errorf("failed to deploy Protos instance: %w", err)
+	}
+	instanceInfo.VMID = vmID
+	log.Infof("Instance with ID '%s' deployed", vmID)
 
 	// create protos data volume
 	log.Infof("creating data volume for Protos instance '%s'", instanceName)
 	volumeID, err := provider.NewVolume(instanceName, 30000, cloudLocation)
 	if err != nil {
-		return InstanceInfo{}, fmt.Errorf("failed to create data volume: %w", err)
+		return instanceInfo, fmt.Errorf("failed to create data volume: %w", err)
 	}
+	instanceInfo.Volumes = []VolumeInfo{{VolumeID: volumeID, Name: instanceName}}
 
 	// attach volume to instance
 	err = provider.AttachVolume(volumeID, vmID, cloudLocation)
 	if err != nil {
-		return InstanceInfo{}, fmt.Errorf("failed to attach volume to instance '%s': %w", instanceName, err)
+		return instanceInfo, fmt.Errorf("failed to attach volume to instance '%s': %w", instanceName, err)
 	}
 
 	// start protos instance
 	log.Infof("Starting instance '%s'", instanceName)
 	err = provider.StartInstance(vmID, cloudLocation)
 	if err != nil {
-		return InstanceInfo{}, fmt.Errorf("failed to start instance: %w", err)
+		return instanceInfo, fmt.Errorf("failed to start instance: %w", err)
 	}
 
-	// get instance info again
+	// get instance info
 	instanceUpdate, err := provider.GetInstanceInfo(vmID, cloudLocation)
 	if err != nil {
-		return InstanceInfo{}, fmt.Errorf("failed to get instance info: %w", err)
+		return instanceInfo, fmt.Errorf("failed to get instance info: %w", err)
 	}
 	instanceInfo.PublicIP = instanceUpdate.PublicIP
 	instanceInfo.Volumes = instanceUpdate.Volumes
 
+	// the VM is saved, so it can be removed using DeleteInstance if the rest of the deployment fails
+	err = cm.db.Tx(fmt.Sprintf("Create VM for instance '%s'", instanceName), func(tx *db.Tx) error {
+		return cm.updateDeployedInstance(tx, instanceInfo)
+	})
+	if err != nil {
+		return instanceInfo, fmt.Errorf("failed to save instance '%s': %w", instanceName, err)
+	}
+
 	// wait for port 22 to be open
 	err = util.WaitForPort(instanceInfo.PublicIP, "22", 20)
 	if err != nil {
-		return InstanceInfo{}, fmt.Errorf("failed to deploy instance: %w", err)
+		return instanceInfo, fmt.Errorf("failed to deploy instance: %w", err)
 	}
 
 	// connect via SSH
 	sshCon, err := pcrypto.NewConnection(instanceInfo.PublicIP, "root", instanceSSHKey.SSHAuth(), 10)
 	if err != nil {
-		return InstanceInfo{}, err
+		return instanceInfo, err
 	}
 
 	// retrieve instance public key via SSH
 	instanceInfo.PublicKey, err = pcrypto.ExecuteCommand(fmt.Sprintf("cat %s", protosPublicKey), sshCon)
+	sshCon.Close()
 	if err != nil {
-		return InstanceInfo{}, err
+		return instanceInfo, err
 	}
 
-	// close SSH connection
-	sshCon.Close()
-
 	p2pClient, err := cm.p2p.AddPeer(instanceInfo)
 	if err != nil {
-		return InstanceInfo{}, fmt.Errorf("failed to initialize instance: %w", err)
+		return instanceInfo, fmt.Errorf("failed to initialize instance: %w", err)
 	}
 
 	// do the initialization
 	log.Infof("Initializing instance '%s'", instanceName)
 	resp, err := p2pClient.Init(context.TODO(), &proto.InitRequest{OriginDevice: thisDevice.GetName(), OriginDevicePublicKey: thisDevice.GetPublicKey(), Network: instanceInfo.Network, InstanceName: instanceName})
 	if err != nil {
-		return InstanceInfo{}, fmt.Errorf("failed to initialize instance: %w", err)
+		return instanceInfo, fmt.Errorf("failed to initialize instance: %w", err)
 	}
 
 	instanceUpdate, err = provider.GetInstanceInfo(vmID, cloudLocation)
 	if err != nil {
-		return InstanceInfo{}, fmt.Errorf("failed to get instance info: %w", err)
+		return instanceInfo, fmt.Errorf("failed to get instance info: %w", err)
 	}
 
 	// final save instance info
 	instanceInfo.InternalIP = resp.InstanceIp
 	instanceInfo.Architecture = resp.Architecture
+	instanceInfo.Multiaddrs = resp.ListenAddrs
 	instanceInfo.Status = instanceUpdate.Status
 
-	err = db.Insert(cm.db, createInstanceInsertMapper(instanceInfo))
+	err = cm.db.Tx(fmt.Sprintf("Deploy instance '%s'", instanceName), func(tx *db.Tx) error {
+		return cm.updateDeployedInstance(tx, instanceInfo)
+	})
 	if err != nil {
-		return InstanceInfo{}, fmt.Errorf("failed to save instance '%s': %w", instanceName, err)
+		return instanceInfo, fmt.Errorf("failed to save instance '%s': %w", instanceName, err)
 	}
+	return instanceInfo, nil
+}
 
-	log.Infof("Instance '%s' at '%s' is ready", instanceName, instanceInfo.PublicIP)
+// cleanupFailedDeployment deletes the cloud resources of an instance that failed to deploy, and releases its name and
+// network. Resources that can't be deleted are kept in the db, so the instance can be removed later
+func (cm *Manager) cleanupFailedDeployment(provider CloudProvider, instanceInfo InstanceInfo) {
+	if !strings.HasPrefix(instanceInfo.VMID, reservedVMIDPrefix) {
+		log.Infof("Deleting instance '%s' (%s) after the failed deployment", instanceInfo.Name, instanceInfo.VMID)
+		if err := provider.StopInstance(instanceInfo.VMID, instanceInfo.Location); err != nil {
+			log.Debugf("Failed to stop instance '%s': %s", instanceInfo.Name, err.Error())
+		}
+		if err := provider.DeleteInstance(instanceInfo.VMID, instanceInfo.Location); err != nil {
+			log.Errorf("Failed to delete instance '%s' after the failed deployment. Remove it using 'instance rm': %s", instanceInfo.Name, err.Error())
+			return
+		}
+	}
+	for _, vol := range instanceInfo.Volumes {
+		log.Infof("Deleting volume '%s' (%s) of instance '%s'", vol.Name, vol.VolumeID, instanceInfo.Name)
+		if err := provider.DeleteVolume(vol.VolumeID, instanceInfo.Location); err != nil {
+			log.Errorf("Failed to delete volume '%s' after the failed deployment: %s", vol.Name, err.Error())
+		}
+	}
 
-	return instanceInfo, nil
+	err := db.Delete(cm.db, createInstanceDeleteByNameQuery(instanceInfo.Name))
+	if err != nil {
+		log.Errorf("Failed to release instance '%s' after the failed deployment: %s", instanceInfo.Name, err.Error())
+	}
 }
 
 // InitDevInstance initializes an existing instance, without deploying one. Used for development purposes
@@ -391,9 +474,10 @@ func (cm *Manager) InitDevInstance(instanceName string, cloudName string, locati
 
 	instanceInfo.InternalIP = ip.String()
 	instanceInfo.Architecture = resp.Architecture
+	instanceInfo.Multiaddrs = resp.ListenAddrs
 	instanceInfo.Network = developmentNetwork.String()
 
-	err = db.Insert(cm.db, createInstanceInsertMapper(instanceInfo))
+	err = cm.saveNewInstance(fmt.Sprintf("Initialize dev instance '%s'", instanceName), instanceInfo)
 	if err != nil {
 		return fmt.Errorf("failed to save dev instance '%s': %w", instanceName, err)
 	}
@@ -410,8 +494,8 @@ func (cm *Manager) DeleteInstance(name string) error {
 		return fmt.Errorf("could not retrieve instance '%s': %w", name, err)
 	}
 
-	// if local only, ignore any cloud resources
-	if instance.CloudType != string(Local) {
+	// if local only, ignore any cloud resources. Reserved instances don't have a VM, or its ID was never saved
+	if instance.CloudType != string(Local) && !strings.HasPrefix(instance.VMID, reservedVMIDPrefix) {
 		provider, err := cm.GetProvider(instance.CloudName)
 		if err != nil {
 			return fmt.Errorf("could not retrieve cloud '%s': %w", name, err)
@@ -495,7 +579,7 @@ func (cm *Manager) StartInstance(name string) error {
 	instance.PublicIP = info.PublicIP
 	instance.Volumes = info.Volumes
 
-	err = db.Update(cm.db, createInstanceUpdateMapper(instance))
+	err = cm.updateInstance(cm.db, instance)
 	if err != nil {
 		return fmt.Errorf("failed to save instance '%s': %w", name, err)
 	}
@@ -594,7 +678,7 @@ func (cm *Manager) LogsRemoteInstance(name string) (string, error) {
 func (cm *Manager) GetInstance(name string) (InstanceInfo, error) {
 
 	instanceModel := sq.New[db.INSTANCE]("")
-	instance, err := db.SelectOne(cm.db, createInstanceQueryMapper(instanceModel, []sq.Predicate{instanceModel.NAME.EqString(name)}))
+	instance, err := db.SelectOne(cm.db, createInstanceQueryMapper(instanceModel, []sq.Predicate{instanceModel.NAME.EqString(name)}, cm.sm))
 	if err != nil {
 		return instance, fmt.Errorf("failed to retrieve instance: %w", err)
 	}
@@ -622,9 +706,64 @@ func (cm *Manager) GetInstance(name string) (InstanceInfo, error) {
 	return instance, nil
 }
 
+// AllocateNetwork allocates an unused network, which can be assigned to an instance or a device
+func (cm *Manager) AllocateNetwork() (net.IPNet, error) {
+	usr, err := cm.um.GetAdmin()
+	if err != nil {
+		return net.IPNet{}, fmt.Errorf("failed to allocate network: %w", err)
+	}
+
+	instances, err := cm.GetInstances()
+	if err != nil {
+		return net.IPNet{}, fmt.Errorf("failed to allocate network: %w", err)
+	}
+
+	return allocateNetwork(instances, usr.GetDevices())
+}
+
+// SetInstancePublicKey updates the public key of an instance in the db, and is used when the instance rotates its key
+func (cm *Manager) SetInstancePublicKey(name string, publicKey string) error {
+	return cm.db.Tx(fmt.Sprintf("Rotate key of instance '%s'", name), func(tx *db.Tx) error {
+		instanceModel := sq.New[db.INSTANCE]("")
+		instance, err := db.SelectOne(tx, createInstanceQueryMapper(instanceModel, []sq.Predicate{instanceModel.NAME.EqString(name)}, cm.sm))
+		if err != nil {
+			return fmt.Errorf("failed to retrieve instance '%s': %w", name, err)
+		}
+
+		instance.PublicKey = publicKey
+		err = cm.updateInstance(tx, instance)
+		if err != nil {
+			return fmt.Errorf("failed to update public key for instance '%s': %w", name, err)
+		}
+		return nil
+	})
+}
+
+// SetInstanceMultiaddrs updates the libp2p listen addresses of an instance in the db, if they changed. It's used by
+// the instances to publish their addresses on startup, since the transports can change between restarts
+func (cm *Manager) SetInstanceMultiaddrs(name string, multiaddrs []string) error {
+	return cm.db.Tx(fmt.Sprintf("Update multiaddrs of instance '%s'", name), func(tx *db.Tx) error {
+		instanceModel := sq.New[db.INSTANCE]("")
+		instance, err := db.SelectOne(tx, createInstanceQueryMapper(instanceModel, []sq.Predicate{instanceModel.NAME.EqString(name)}, cm.sm))
+		if err != nil {
+			return fmt.Errorf("failed to retrieve instance '%s': %w", name, err)
+		}
+		if slices.Equal(instance.Multiaddrs, multiaddrs) {
+			return nil
+		}
+
+		instance.Multiaddrs = multiaddrs
+		err = cm.updateInstance(tx, instance)
+		if err != nil {
+			return fmt.Errorf("failed to update multiaddrs for instance '%s': %w", name, err)
+		}
+		return nil
+	})
+}
+
 // GetInstances returns all the instances from the db
 func (cm *Manager) GetInstances() ([]InstanceInfo, error) {
-	instances, err := db.SelectMultiple(cm.db, createInstanceQueryMapper(sq.New[db.INSTANCE](""), nil))
+	instances, err := db.SelectMultiple(cm.db, createInstanceQueryMapper(sq.New[db.INSTANCE](""), nil, cm.sm))
 	if err != nil {
 		return instances, fmt.Errorf("failed to retrieve instances: %w", err)
 	}
@@ -664,7 +803,7 @@ func (cm *Manager) retrieveInstanceStatus(wg *sync.WaitGroup, instance *Instance
 
 // GetInstances returns all the instances from the db
 func (cm *Manager) GetInstancesWithUpdatedStatus() ([]InstanceInfo, error) {
-	instances, err := db.SelectMultiple(cm.db, createInstanceQueryMapper(sq.New[db.INSTANCE](""), nil))
+	instances, err := db.SelectMultiple(cm.db, createInstanceQueryMapper(sq.New[db.INSTANCE](""), nil, cm.sm))
 	if err != nil {
 		return instances, fmt.Errorf("failed to retrieve instances: %w", err)
 	}
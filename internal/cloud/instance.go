@@ -5,17 +5,23 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/bokwoon95/sq"
 	"github.com/protosio/protos/internal/auth"
 	"github.com/protosio/protos/internal/db"
+	"github.com/protosio/protos/internal/p2p"
+	"github.com/protosio/protos/internal/pcrypto"
 )
 
 const (
 	instanceDS = "instance"
 	cloudDS    = "cloud"
 	netSpace   = "10.100.0.0/16"
+
+	// MeshNetwork is the address space that is shared by all the instances and devices
+	MeshNetwork = netSpace
 )
 
 const (
@@ -25,6 +31,9 @@ const (
 	ServerStateChanging = "changing"
 
 	protosPublicKey = "/var/lib/protos/protos_key.pub"
+
+	// reservedVMIDPrefix marks the VM ID of an instance that is reserved, but doesn't have a VM yet
+	reservedVMIDPrefix = "reserved:"
 )
 
 func createInstanceInsertMapper(instance InstanceInfo) func() (sq.Table, func(*sq.Column)) {
@@ -36,6 +45,7 @@ func createInstanceInsertMapper(instance InstanceInfo) func() (sq.Table, func(*s
 			col.SetString(i.SSH_KEY_SEED, instance.SSHKeySeed)
 			col.SetString(i.PUBLIC_KEY, instance.PublicKey)
 			col.SetString(i.PUBLIC_IP, instance.PublicIP)
+			col.SetString(i.MULTIADDRS, strings.Join(instance.Multiaddrs, ","))
 			col.SetString(i.INTERNAL_IP, instance.InternalIP)
 			col.SetString(i.CLOUD_TYPE, instance.CloudType)
 			col.SetString(i.CLOUD_NAME, instance.CloudName)
@@ -56,6 +66,7 @@ func createInstanceUpdateMapper(instance InstanceInfo) func() (sq.Table, func(*s
 			col.SetString(i.SSH_KEY_SEED, instance.SSHKeySeed)
 			col.SetString(i.PUBLIC_KEY, instance.PublicKey)
 			col.SetString(i.PUBLIC_IP, instance.PublicIP)
+			col.SetString(i.MULTIADDRS, strings.Join(instance.Multiaddrs, ","))
 			col.SetString(i.INTERNAL_IP, instance.InternalIP)
 			col.SetString(i.CLOUD_TYPE, instance.CloudType)
 			col.SetString(i.CLOUD_NAME, instance.CloudName)
@@ -67,15 +78,29 @@ func createInstanceUpdateMapper(instance InstanceInfo) func() (sq.Table, func(*s
 	}
 }
 
-func createInstanceQueryMapper(i db.INSTANCE, predicates []sq.Predicate) func() (sq.Table, func(row *sq.Row) InstanceInfo, []sq.Predicate) {
+// createInstanceByNameUpdateMapper updates an instance identified by its name, including its VM ID. Used while deploying,
+// when the reserved instance doesn't have a VM ID yet
+func createInstanceByNameUpdateMapper(instance InstanceInfo) func() (sq.Table, func(*sq.Column), []sq.Predicate) {
+	return func() (sq.Table, func(*sq.Column), []sq.Predicate) {
+		i := sq.New[db.INSTANCE]("")
+		table, mapper, _ := createInstanceUpdateMapper(instance)()
+		predicates := []sq.Predicate{i.NAME.EqString(instance.Name)}
+		return table, func(col *sq.Column) {
+			col.SetString(i.VM_ID, instance.VMID)
+			mapper(col)
+		}, predicates
+	}
+}
+
+func createInstanceQueryMapper(i db.INSTANCE, predicates []sq.Predicate, sm *pcrypto.Manager) func() (sq.Table, func(row *sq.Row) InstanceInfo, []sq.Predicate) {
 	return func() (sq.Table, func(row *sq.Row) InstanceInfo, []sq.Predicate) {
 		mapper := func(row *sq.Row) InstanceInfo {
-			return InstanceInfo{
+			instance := InstanceInfo{
 				VMID:          row.StringField(i.VM_ID),
 				Name:          row.StringField(i.NAME),
-				SSHKeySeed:    row.StringField(i.SSH_KEY_SEED),
 				PublicKey:     row.StringField(i.PUBLIC_KEY),
 				PublicIP:      row.StringField(i.PUBLIC_IP),
+				Multiaddrs:    multiaddrsFromString(row.StringField(i.MULTIADDRS)),
 				InternalIP:    row.StringField(i.INTERNAL_IP),
 				CloudType:     row.StringField(i.CLOUD_TYPE),
 				CloudName:     row.StringField(i.CLOUD_NAME),
@@ -84,11 +109,26 @@ func createInstanceQueryMapper(i db.INSTANCE, predicates []sq.Predicate) func()
 				ProtosVersion: row.StringField(i.PROTOS_VERSION),
 				Architecture:  row.StringField(i.ARCHITECTURE),
 			}
+			seed := row.StringField(i.SSH_KEY_SEED)
+			instance.plaintextSecrets = seed != "" && !pcrypto.IsSealed(seed)
+			var err error
+			instance.SSHKeySeed, err = openSecret(sm, seed)
+			if err != nil {
+				log.Errorf("Failed to decrypt SSH key seed of instance '%s': %s", instance.Name, err.Error())
+			}
+			return instance
 		}
 		return i, mapper, predicates
 	}
 }
 
+func multiaddrsFromString(multiaddrsString string) []string {
+	if multiaddrsString == "" {
+		return []string{}
+	}
+	return strings.Split(multiaddrsString, ",")
+}
+
 func createInstanceDeleteByNameQuery(name string) func() (sq.Table, []sq.Predicate) {
 	return func() (sq.Table, []sq.Predicate) {
 		i := sq.New[db.INSTANCE]("")
@@ -125,9 +165,10 @@ type MachineSpec struct {
 type InstanceInfo struct {
 	VMID          string
 	Name          string
-	SSHKeySeed    string // private SSH key stored only on the client
-	PublicKey     string // ed25519 public key
-	PublicIP      string // this can be a public or private IP, depending on where the device is located
+	SSHKeySeed    string   // private SSH key, which stays encrypted on machines that don't have the data key
+	PublicKey     string   // ed25519 public key
+	PublicIP      string   // this can be a public or private IP, depending on where the device is located
+	Multiaddrs    []string // libp2p listen addresses, where unspecified IPs stand for the public IP
 	InternalIP    string
 	CloudType     string
 	CloudName     string
@@ -137,6 +178,8 @@ type InstanceInfo struct {
 	Status        string
 	Architecture  string
 	Volumes       []VolumeInfo
+
+	plaintextSecrets bool // the SSH key seed was written before the secrets were encrypted
 }
 
 func (i InstanceInfo) GetPublicKey() string {
@@ -147,10 +190,23 @@ func (i InstanceInfo) GetPublicIP() string {
 	return i.PublicIP
 }
 
+func (i InstanceInfo) GetMultiaddrs() []string {
+	return i.Multiaddrs
+}
+
+// GetMachineID returns the name of the instance, which identifies it in key rotations
+func (i InstanceInfo) GetMachineID() string {
+	return i.Name
+}
+
 func (i InstanceInfo) GetName() string {
 	return i.Name
 }
 
+func (i InstanceInfo) GetType() string {
+	return p2p.MachineTypeInstance
+}
+
 func catchSignals(sigs chan os.Signal, quit chan interface{}) {
 	<-sigs
 	quit <- true
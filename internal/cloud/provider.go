@@ -1,43 +1,52 @@
 package cloud
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/bokwoon95/sq"
 	"github.com/pkg/errors"
 	"github.com/protosio/protos/internal/db"
+	"github.com/protosio/protos/internal/pcrypto"
 )
 
-func createCloudProviderInsertMapper(provider ProviderInfo) func() (sq.Table, func(*sq.Column)) {
+func createCloudProviderInsertMapper(provider ProviderInfo, sealedAuth string) func() (sq.Table, func(*sq.Column)) {
 	return func() (sq.Table, func(*sq.Column)) {
 		c := sq.New[db.CLOUD_PROVIDER]("")
 		return c, func(col *sq.Column) {
 			col.SetString(c.NAME, provider.Name)
 			col.SetString(c.TYPE, provider.Type.String())
-			col.SetJSON(c.AUTH, provider.Auth)
+			col.SetJSON(c.AUTH, sealedAuth)
 		}
 	}
 }
 
-func createCloudProviderUpdateMapper(provider ProviderInfo) func() (sq.Table, func(*sq.Column), []sq.Predicate) {
+func createCloudProviderUpdateMapper(provider ProviderInfo, sealedAuth string) func() (sq.Table, func(*sq.Column), []sq.Predicate) {
 	return func() (sq.Table, func(*sq.Column), []sq.Predicate) {
 		c := sq.New[db.CLOUD_PROVIDER]("")
 		predicates := []sq.Predicate{c.NAME.EqString(provider.Name)}
 		return c, func(col *sq.Column) {
 			col.SetString(c.TYPE, provider.Type.String())
-			col.SetJSON(c.AUTH, provider.Auth)
+			col.SetJSON(c.AUTH, sealedAuth)
 		}, predicates
 	}
 }
 
-func createCloudProviderQueryMapper(c db.CLOUD_PROVIDER, predicates []sq.Predicate) func() (sq.Table, func(row *sq.Row) ProviderInfo, []sq.Predicate) {
+func createCloudProviderQueryMapper(c db.CLOUD_PROVIDER, predicates []sq.Predicate, cm *Manager) func() (sq.Table, func(row *sq.Row) ProviderInfo, []sq.Predicate) {
 	return func() (sq.Table, func(row *sq.Row) ProviderInfo, []sq.Predicate) {
 		mapper := func(row *sq.Row) ProviderInfo {
 			pi := ProviderInfo{
+				cm:   cm,
 				Name: row.StringField(c.NAME),
 				Type: Type(row.StringField(c.TYPE)),
 			}
-			row.JSONField(&pi.Auth, c.AUTH)
+			var auth json.RawMessage
+			row.JSONField(&auth, c.AUTH)
+			err := pi.openAuth(auth)
+			if err != nil {
+				log.Errorf("Failed to decrypt credentials of cloud provider '%s': %s", pi.Name, err.Error())
+			}
 			return pi
 		}
 		return c, mapper, predicates
@@ -99,12 +108,65 @@ type ProviderInfo struct {
 	Name string
 	Type Type
 	Auth map[string]string
+
+	sealedAuth       string // credentials that can't be decrypted on this machine, which are saved unchanged
+	plaintextSecrets bool   // the credentials were written before the secrets were encrypted
+}
+
+// openAuth decrypts the credentials read from the db. Credentials written before the secrets were encrypted are
+// stored as a JSON object, while the encrypted ones are stored as a JSON string
+func (pi *ProviderInfo) openAuth(auth json.RawMessage) error {
+	if len(auth) == 0 || string(auth) == "null" {
+		return nil
+	}
+
+	var sealed string
+	if err := json.Unmarshal(auth, &sealed); err != nil {
+		pi.plaintextSecrets = true
+		return json.Unmarshal(auth, &pi.Auth)
+	}
+
+	plaintext, err := pi.cm.sm.DecryptSecret(sealed)
+	if errors.Is(err, pcrypto.ErrSecretsLocked) {
+		pi.sealedAuth = sealed
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if plaintext == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(plaintext), &pi.Auth)
+}
+
+// sealAuth encrypts the credentials before they are written to the db
+func (pi ProviderInfo) sealAuth() (string, error) {
+	if pi.Auth == nil {
+		return pi.sealedAuth, nil
+	}
+	return sealProviderAuth(pi.cm.sm, pi.Auth)
+}
+
+func sealProviderAuth(sm *pcrypto.Manager, auth map[string]string) (string, error) {
+	authJSON, err := json.Marshal(auth)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode credentials: %w", err)
+	}
+	return sm.EncryptSecret(string(authJSON))
 }
 
 // Save saves the provider information to disk
 func (pi ProviderInfo) Save() error {
+	return pi.save(pi.cm.db)
+}
+
+func (pi ProviderInfo) save(q db.Querier) error {
+	sealedAuth, err := pi.sealAuth()
+	if err != nil {
+		return errors.Wrap(err, "Failed to encrypt cloud provider credentials")
+	}
 
-	err := db.Update(pi.cm.db, createCloudProviderUpdateMapper(pi))
+	err = db.Update(q, createCloudProviderUpdateMapper(pi, sealedAuth))
 	if err != nil {
 		return errors.Wrap(err, "Failed to save cloud provider info")
 	}
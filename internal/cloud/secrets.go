@@ -0,0 +1,119 @@
+package cloud
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bokwoon95/sq"
+	"github.com/protosio/protos/internal/db"
+	"github.com/protosio/protos/internal/pcrypto"
+)
+
+// openSecret decrypts a secret read from the db. Machines that don't have the data key keep the encrypted value, so
+// that they can write it back unchanged
+func openSecret(sm *pcrypto.Manager, value string) (string, error) {
+	plaintext, err := sm.DecryptSecret(value)
+	if errors.Is(err, pcrypto.ErrSecretsLocked) {
+		return value, nil
+	} else if err != nil {
+		return "", err
+	}
+	return plaintext, nil
+}
+
+// insertInstance encrypts the SSH key seed of an instance and saves the instance in the db
+func (cm *Manager) insertInstance(q db.Querier, instance InstanceInfo) error {
+	return sealAndInsertInstance(q, cm.sm, instance)
+}
+
+func sealAndInsertInstance(q db.Querier, sm *pcrypto.Manager, instance InstanceInfo) error {
+	var err error
+	instance.SSHKeySeed, err = sm.EncryptSecret(instance.SSHKeySeed)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt SSH key seed: %w", err)
+	}
+	return db.Insert(q, createInstanceInsertMapper(instance))
+}
+
+// updateInstance encrypts the SSH key seed of an instance and updates the instance in the db
+func (cm *Manager) updateInstance(q db.Querier, instance InstanceInfo) error {
+	var err error
+	instance.SSHKeySeed, err = cm.sm.EncryptSecret(instance.SSHKeySeed)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt SSH key seed: %w", err)
+	}
+	return db.Update(q, createInstanceUpdateMapper(instance))
+}
+
+// updateDeployedInstance saves an instance that is being deployed. The instance is matched by name, since its VM ID is
+// only known after the VM is created
+func (cm *Manager) updateDeployedInstance(q db.Querier, instance InstanceInfo) error {
+	var err error
+	instance.SSHKeySeed, err = cm.sm.EncryptSecret(instance.SSHKeySeed)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt SSH key seed: %w", err)
+	}
+	return db.UpdateOne(q, createInstanceByNameUpdateMapper(instance))
+}
+
+// SealSecrets encrypts the cloud credentials and SSH key seeds that were written to the db before the secrets were
+// encrypted. It requires the data key, so it only runs on the devices of the user. All the secrets are encrypted in a
+// single commit, so the db never holds a mix of the two
+func (cm *Manager) SealSecrets() error {
+	return cm.db.Tx("Encrypt cloud credentials and SSH key seeds", func(tx *db.Tx) error {
+		providers, err := db.SelectMultiple(tx, createCloudProviderQueryMapper(sq.New[db.CLOUD_PROVIDER](""), nil, cm))
+		if err != nil {
+			return fmt.Errorf("failed to retrieve cloud providers: %w", err)
+		}
+		for _, provider := range providers {
+			if !provider.plaintextSecrets {
+				continue
+			}
+			err := provider.save(tx)
+			if err != nil {
+				return fmt.Errorf("failed to encrypt credentials of cloud provider '%s': %w", provider.Name, err)
+			}
+			log.Infof("Encrypted credentials of cloud provider '%s'", provider.Name)
+		}
+
+		instances, err := db.SelectMultiple(tx, createInstanceQueryMapper(sq.New[db.INSTANCE](""), nil, cm.sm))
+		if err != nil {
+			return fmt.Errorf("failed to retrieve instances: %w", err)
+		}
+		for _, instance := range instances {
+			if !instance.plaintextSecrets {
+				continue
+			}
+			err := cm.updateInstance(tx, instance)
+			if err != nil {
+				return fmt.Errorf("failed to encrypt SSH key seed of instance '%s': %w", instance.Name, err)
+			}
+			log.Infof("Encrypted SSH key seed of instance '%s'", instance.Name)
+		}
+		return nil
+	})
+}
+
+// ImportProvider saves a cloud provider restored from an export of the state. Like the other parts of the state, it
+// runs in the transaction of the import, before the cloud manager is created
+func ImportProvider(q db.Querier, sm *pcrypto.Manager, name string, cloudType string, auth map[string]string) error {
+	provider := ProviderInfo{Name: name, Type: Type(cloudType), Auth: auth}
+	sealedAuth, err := sealProviderAuth(sm, auth)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt credentials of cloud provider '%s': %w", name, err)
+	}
+	err = db.Insert(q, createCloudProviderInsertMapper(provider, sealedAuth))
+	if err != nil {
+		return fmt.Errorf("failed to save cloud provider '%s': %w", name, err)
+	}
+	return nil
+}
+
+// ImportInstance saves an instance restored from an export of the state, in the transaction of the import
+func ImportInstance(q db.Querier, sm *pcrypto.Manager, instance InstanceInfo) error {
+	err := sealAndInsertInstance(q, sm, instance)
+	if err != nil {
+		return fmt.Errorf("failed to save instance '%s': %w", instance.Name, err)
+	}
+	return nil
+}
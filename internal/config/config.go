@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/Masterminds/semver"
 	"github.com/pkg/errors"
@@ -29,8 +30,55 @@ type Config struct {
 	AppStoreName    string
 	AppStoreHost    string
 	ProcsQuit       sync.Map
-	ExternalDNS     string // format: <ip>:<port>
+	ExternalDNS     DNSServers // format: [tls://]<ip>:<port>[#<tls server name>]
+	DNSHealthAware  bool       // only resolve the apps that are running
 	Version         *semver.Version
+
+	KeyRotationInterval time.Duration // 0 disables key rotation
+	KeyRotationOverlap  time.Duration
+
+	PublicDNS PublicDNSConfig
+	P2P       P2PConfig
+}
+
+// P2PConfig configures the transports used for the p2p connections, and the addresses they listen on
+type P2PConfig struct {
+	Transports    []string // any of tcp, quic, ws and webtransport
+	ListenAddrs   []string // multiaddrs that replace the ones generated from the transports and ports
+	TCPPort       int      // used by the tcp transport
+	UDPPort       int      // shared by the quic and webtransport transports
+	WebSocketPort int
+}
+
+// PublicDNSConfig configures the public records of the apps with the PublicDNS capability
+type PublicDNSConfig struct {
+	Provider      string // only rfc2136 is supported. Empty disables public DNS
+	Zone          string
+	Server        string // format: <ip>:<port>
+	TSIGKey       string
+	TSIGSecret    string // base64 encoded
+	TSIGAlgorithm string // defaults to hmac-sha256
+	TTL           uint32
+}
+
+// DNSServers is a list of DNS servers, in order of preference. A single server can also be provided as a string in
+// the config file
+type DNSServers []string
+
+// UnmarshalYAML allows the DNS servers to be provided as a string or as a list
+func (ds *DNSServers) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var server string
+	if err := unmarshal(&server); err == nil {
+		*ds = DNSServers{server}
+		return nil
+	}
+
+	var servers []string
+	if err := unmarshal(&servers); err != nil {
+		return err
+	}
+	*ds = servers
+	return nil
 }
 
 var config = Config{
@@ -45,8 +93,18 @@ var config = Config{
 	AppStoreURL:     "https://apps.protos.io",
 	AppStoreName:    "protos.io",
 	AppStoreHost:    "apps.protos.io",
-	ExternalDNS:     "8.8.8.8:53",
+	ExternalDNS:     DNSServers{"8.8.8.8:53", "1.1.1.1:53"},
 	ProcsQuit:       sync.Map{},
+
+	KeyRotationInterval: 720 * time.Hour,
+	KeyRotationOverlap:  time.Hour,
+
+	P2P: P2PConfig{
+		Transports:    []string{"quic", "tcp"},
+		TCPPort:       10500,
+		UDPPort:       10500,
+		WebSocketPort: 10501,
+	},
 }
 
 // Gconfig maintains a global view of the application configuration parameters.
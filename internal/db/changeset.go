@@ -0,0 +1,294 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"sync"
+)
+
+// ChangesetBranch is the branch that holds the staged changes. There is at most one changeset at a time, and only
+// the main branch is synchronised with the peers, so staged changes don't reach the instances until they are applied
+const ChangesetBranch = "changeset"
+
+const changesetCommitMessage = "Staged changes"
+
+// sqlExecutor runs queries, either using the connection pool of the db or a single connection
+type sqlExecutor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+}
+
+// changeset holds the connection that has the changeset branch checked out, while a changeset is open
+type changeset struct {
+	mu       sync.RWMutex
+	database string
+	conn     *sql.Conn
+	// connMu serializes the use of the connection. A transaction holds it until it finishes, since the statements
+	// sent on the connection in the meantime would otherwise become part of the transaction
+	connMu sync.Mutex
+}
+
+// ChangesetTable holds the staged changes of a table
+type ChangesetTable struct {
+	Table string
+	Rows  []RowDiff
+}
+
+//
+// Query routing
+//
+
+// Staged returns a view of the db that writes to the changeset branch while a changeset is open, and to the main
+// branch otherwise. Reads made using the view see the staged changes
+func (db *DB) Staged() *DB {
+	return &DB{DB: db.DB, signer: db.signer, changeset: db.changeset, staged: true}
+}
+
+// lockExecutor prevents the changeset from being applied or discarded while a staged statement or transaction runs,
+// and gives it exclusive use of the changeset connection. It returns the function that releases the locks
+func (db *DB) lockExecutor() func() {
+	if !db.staged || db.changeset == nil {
+		return func() {}
+	}
+	db.changeset.mu.RLock()
+	if db.changeset.conn == nil {
+		return db.changeset.mu.RUnlock
+	}
+	db.changeset.connMu.Lock()
+	return func() {
+		db.changeset.connMu.Unlock()
+		db.changeset.mu.RUnlock()
+	}
+}
+
+// executor returns the connection that has the changeset branch checked out, or the connection pool of the db. The
+// caller holds the lock returned by lockExecutor while it uses the executor
+func (db *DB) executor() sqlExecutor {
+	if db.staged && db.changeset != nil && db.changeset.conn != nil {
+		return db.changeset.conn
+	}
+	return db.DB.DB
+}
+
+// ExecContext runs a statement on the branch used by the db
+func (db *DB) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	defer db.lockExecutor()()
+	return db.executor().ExecContext(ctx, query, args...)
+}
+
+// QueryContext runs a query on the branch used by the db. Rows that are still open when the changeset is closed keep
+// the changeset connection until they are closed
+func (db *DB) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	defer db.lockExecutor()()
+	return db.executor().QueryContext(ctx, query, args...)
+}
+
+// PrepareContext prepares a statement on the branch used by the db
+func (db *DB) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	defer db.lockExecutor()()
+	return db.executor().PrepareContext(ctx, query)
+}
+
+//
+// Changeset methods
+//
+
+func (db *DB) changesetExists() (bool, error) {
+	var count int
+	err := db.DB.DB.QueryRow("SELECT COUNT(*) FROM dolt_branches WHERE name = ?", ChangesetBranch).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check changeset branch: %w", err)
+	}
+	return count > 0, nil
+}
+
+// attachChangeset checks out the changeset branch on a dedicated connection. The caller holds the lock
+func (db *DB) attachChangeset() error {
+	ctx := context.Background()
+	conn, err := db.DB.DB.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to open changeset connection: %w", err)
+	}
+	_, err = conn.ExecContext(ctx, fmt.Sprintf("USE `%s/%s`", db.changeset.database, ChangesetBranch))
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to check out changeset branch: %w", err)
+	}
+	db.changeset.conn = conn
+	return nil
+}
+
+// detachChangeset discards the dedicated connection. It is not switched back to the main branch and returned to the
+// pool, since a statement that still holds it would then run on the main branch. Closing the connection waits for the
+// rows that are still open, and statements that use it afterwards fail. The caller holds the lock
+func (db *DB) detachChangeset() {
+	conn := db.changeset.conn
+	db.changeset.conn = nil
+	conn.Raw(func(driverConn any) error { return driver.ErrBadConn })
+}
+
+// commitChangeset commits the staged changes on the changeset branch, so they can be compared and merged. The caller
+// holds the lock
+func (db *DB) commitChangeset() error {
+	ctx := context.Background()
+	var changes int
+	err := db.changeset.conn.QueryRowContext(ctx, "SELECT COUNT(*) FROM dolt_status").Scan(&changes)
+	if err != nil {
+		return fmt.Errorf("failed to check staged changes: %w", err)
+	}
+	if changes == 0 {
+		return nil
+	}
+	_, err = db.changeset.conn.ExecContext(ctx, "CALL DOLT_COMMIT('-A', '-m', ?)", changesetCommitMessage)
+	if err != nil {
+		return fmt.Errorf("failed to commit staged changes: %w", err)
+	}
+	return nil
+}
+
+// resumeChangeset checks out the changeset branch if a changeset was left open when the db was closed
+func (db *DB) resumeChangeset() error {
+	exists, err := db.changesetExists()
+	if err != nil || !exists {
+		return err
+	}
+	db.changeset.mu.Lock()
+	defer db.changeset.mu.Unlock()
+	return db.attachChangeset()
+}
+
+// HasChangeset checks if a changeset is open
+func (db *DB) HasChangeset() bool {
+	db.changeset.mu.RLock()
+	defer db.changeset.mu.RUnlock()
+	return db.changeset.conn != nil
+}
+
+// OpenChangeset creates the changeset branch, starting from the main branch. Until the changeset is applied or
+// discarded, the staged views of the db write to the branch
+func (db *DB) OpenChangeset() error {
+	db.changeset.mu.Lock()
+	defer db.changeset.mu.Unlock()
+	if db.changeset.conn != nil {
+		return fmt.Errorf("a changeset is already open")
+	}
+
+	_, err := db.DB.DB.Exec("CALL DOLT_BRANCH(?)", ChangesetBranch)
+	if err != nil {
+		return fmt.Errorf("failed to create changeset branch: %w", err)
+	}
+	return db.attachChangeset()
+}
+
+// PlanChangeset returns the changes staged in the changeset, compared to the main branch at the moment the
+// changeset was opened
+func (db *DB) PlanChangeset() ([]ChangesetTable, error) {
+	db.changeset.mu.Lock()
+	defer db.changeset.mu.Unlock()
+	if db.changeset.conn == nil {
+		return nil, fmt.Errorf("no changeset is open")
+	}
+	err := db.commitChangeset()
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := "main..." + ChangesetBranch
+	rows, err := db.DB.DB.Query("SELECT to_table_name FROM DOLT_DIFF_SUMMARY(?) WHERE data_change = TRUE", revisions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve staged tables: %w", err)
+	}
+	tables := []string{}
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to read staged table: %w", err)
+		}
+		tables = append(tables, table)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to retrieve staged tables: %w", err)
+	}
+
+	plan := []ChangesetTable{}
+	for _, table := range tables {
+		diffs, err := db.diffRows(table, revisions)
+		if err != nil {
+			return nil, err
+		}
+		plan = append(plan, ChangesetTable{Table: table, Rows: diffs})
+	}
+	return plan, nil
+}
+
+// ApplyChangeset merges the changeset into the main branch, from where the changes propagate to the peers. If the
+// merge conflicts with changes made since the changeset was opened, it is aborted and the changeset stays open
+func (db *DB) ApplyChangeset() (string, error) {
+	db.changeset.mu.Lock()
+	defer db.changeset.mu.Unlock()
+	if db.changeset.conn == nil {
+		return "", fmt.Errorf("no changeset is open")
+	}
+	err := db.commitChangeset()
+	if err != nil {
+		return "", err
+	}
+
+	// the merge runs on a single connection, so that the conflicts can be checked and the merge aborted in the same
+	// session
+	ctx := context.Background()
+	conn, err := db.DB.DB.Conn(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to apply changeset: %w", err)
+	}
+	defer conn.Close()
+
+	_, err = conn.ExecContext(ctx, "CALL DOLT_MERGE(?)", ChangesetBranch)
+	if err != nil {
+		return "", fmt.Errorf("failed to apply changeset: %w", err)
+	}
+	var conflicts int
+	err = conn.QueryRowContext(ctx, "SELECT COUNT(*) FROM dolt_conflicts").Scan(&conflicts)
+	if err != nil {
+		return "", fmt.Errorf("failed to check changeset conflicts: %w", err)
+	}
+	if conflicts > 0 {
+		if _, err := conn.ExecContext(ctx, "CALL DOLT_MERGE('--abort')"); err != nil {
+			return "", fmt.Errorf("failed to abort conflicting changeset: %w", err)
+		}
+		return "", fmt.Errorf("changeset conflicts with changes made to %d tables since it was opened", conflicts)
+	}
+
+	db.detachChangeset()
+	_, err = db.DB.DB.Exec("CALL DOLT_BRANCH('-D', ?)", ChangesetBranch)
+	if err != nil {
+		return "", fmt.Errorf("failed to delete changeset branch: %w", err)
+	}
+
+	head, err := db.GetLastCommit("main")
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve commit that applied the changeset: %w", err)
+	}
+	return head.Hash, nil
+}
+
+// DiscardChangeset drops the staged changes
+func (db *DB) DiscardChangeset() error {
+	db.changeset.mu.Lock()
+	defer db.changeset.mu.Unlock()
+	if db.changeset.conn == nil {
+		return fmt.Errorf("no changeset is open")
+	}
+
+	db.detachChangeset()
+	_, err := db.DB.DB.Exec("CALL DOLT_BRANCH('-D', ?)", ChangesetBranch)
+	if err != nil {
+		return fmt.Errorf("failed to delete changeset branch: %w", err)
+	}
+	return nil
+}
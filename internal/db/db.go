@@ -5,29 +5,68 @@ import (
 
 	"github.com/bokwoon95/sq"
 	"github.com/nustiueudinastea/doltswarm"
+	"github.com/protosio/protos/internal/db/migrations"
 	"github.com/protosio/protos/internal/util"
 )
 
 var logger = util.GetLogger("db")
 var Instance *doltswarm.DB
 
-func Open(workDir string, dbName string, signer doltswarm.Signer) (*DB, error) {
+// SchemaVersion is the version of the database schema. Peers only synchronise their databases when they use the
+// same schema version, so that commits made using an incompatible schema are never merged
+var SchemaVersion = migrations.Latest()
+
+// Open opens the replicated db, and the local database that holds the tables of the provided scope
+func Open(workDir string, dbName string, signer doltswarm.Signer, scope Scope) (*DB, error) {
 	dbi, err := doltswarm.Open(workDir, dbName, logger, signer)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create db: %v", err)
 	}
 
-	return &DB{dbi}, nil
+	err = openLocal(dbi.DB, scope)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open local database: %w", err)
+	}
+
+	applied, err := migrations.Run(dbi.DB)
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate db: %w", err)
+	}
+	if applied > 0 {
+		logger.Infof("Applied %d db migrations, schema version is %d", applied, SchemaVersion)
+	}
+
+	db := &DB{DB: dbi, signer: signer, changeset: &changeset{}}
+	err = dbi.QueryRow("SELECT DATABASE()").Scan(&db.changeset.database)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve database name: %w", err)
+	}
+	err = db.resumeChangeset()
+	if err != nil {
+		return nil, fmt.Errorf("failed to resume changeset: %w", err)
+	}
+
+	return db, nil
 }
 
 type DB struct {
 	*doltswarm.DB
+
+	signer    doltswarm.Signer
+	changeset *changeset
+	staged    bool // queries are sent to the changeset branch while a changeset is open
+}
+
+// Querier runs the queries of the helpers below. It's implemented by the db, and by the transactions created using
+// Tx, so the same mappers can be used in both cases
+type Querier interface {
+	sq.DB
 }
 
 // Insert inserts a new entry in the database using the sq query builder
-func Insert(db *DB, mc func() (sq.Table, func(*sq.Column))) error {
+func Insert(q Querier, mc func() (sq.Table, func(*sq.Column))) error {
 	t, mapper := mc()
-	_, err := sq.Exec(db, sq.
+	_, err := sq.Exec(q, sq.
 		InsertInto(t).
 		ColumnValues(mapper).
 		SetDialect(sq.DialectMySQL),
@@ -35,9 +74,9 @@ func Insert(db *DB, mc func() (sq.Table, func(*sq.Column))) error {
 	return err
 }
 
-func Update(db *DB, mc func() (sq.Table, func(*sq.Column), []sq.Predicate)) error {
+func Update(q Querier, mc func() (sq.Table, func(*sq.Column), []sq.Predicate)) error {
 	t, mapper, predicates := mc()
-	_, err := sq.Exec(db, sq.
+	_, err := sq.Exec(q, sq.
 		Update(t).
 		SetFunc(mapper).
 		Where(predicates...).
@@ -46,9 +85,27 @@ func Update(db *DB, mc func() (sq.Table, func(*sq.Column), []sq.Predicate)) erro
 	return err
 }
 
-func SelectOne[T any](db *DB, mc func() (sq.Table, func(row *sq.Row) T, []sq.Predicate)) (T, error) {
+// UpdateOne is like Update, but fails if the predicates don't match any row
+func UpdateOne(q Querier, mc func() (sq.Table, func(*sq.Column), []sq.Predicate)) error {
+	t, mapper, predicates := mc()
+	res, err := sq.Exec(q, sq.
+		Update(t).
+		SetFunc(mapper).
+		Where(predicates...).
+		SetDialect(sq.DialectMySQL),
+	)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("failed to update: no matching row")
+	}
+	return nil
+}
+
+func SelectOne[T any](q Querier, mc func() (sq.Table, func(row *sq.Row) T, []sq.Predicate)) (T, error) {
 	t, mapper, predicates := mc()
-	res, err := sq.FetchOne(db, sq.
+	res, err := sq.FetchOne(q, sq.
 		From(t).
 		Where(predicates...).
 		SetDialect(sq.DialectMySQL),
@@ -60,9 +117,9 @@ func SelectOne[T any](db *DB, mc func() (sq.Table, func(row *sq.Row) T, []sq.Pre
 	return res, nil
 }
 
-func SelectMultiple[T any](db *DB, mc func() (sq.Table, func(row *sq.Row) T, []sq.Predicate)) ([]T, error) {
+func SelectMultiple[T any](q Querier, mc func() (sq.Table, func(row *sq.Row) T, []sq.Predicate)) ([]T, error) {
 	t, mapper, predicates := mc()
-	res, err := sq.FetchAll(db, sq.
+	res, err := sq.FetchAll(q, sq.
 		From(t).
 		Where(predicates...).
 		SetDialect(sq.DialectMySQL),
@@ -74,9 +131,9 @@ func SelectMultiple[T any](db *DB, mc func() (sq.Table, func(row *sq.Row) T, []s
 	return res, nil
 }
 
-func Delete(db *DB, mc func() (sq.Table, []sq.Predicate)) error {
+func Delete(q Querier, mc func() (sq.Table, []sq.Predicate)) error {
 	t, predicates := mc()
-	_, err := sq.Exec(db, sq.
+	_, err := sq.Exec(q, sq.
 		DeleteFrom(t).
 		Where(predicates...).
 		SetDialect(sq.DialectMySQL),
@@ -0,0 +1,255 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Types of row changes, as reported by Dolt
+const (
+	DiffAdded    = "added"
+	DiffModified = "modified"
+	DiffRemoved  = "removed"
+)
+
+// redactedValue replaces the values of secret columns in diffs
+const redactedValue = "<redacted>"
+
+var commitHashRegex = regexp.MustCompile(`^[0-9a-v]{32}$`)
+
+// secretColumns lists the columns that are never shown in diffs, indexed by table
+var secretColumns = map[string]map[string]bool{
+	"instances":       {"ssh_key_seed": true},
+	"cloud_providers": {"auth": true},
+	"ssh_keys":        {"private": true},
+}
+
+// Commit is a commit of the replicated db. The committer is the peer ID of the machine that made the commit
+type Commit struct {
+	Hash      string
+	Committer string
+	Email     string
+	Date      time.Time
+	Message   string
+}
+
+// TableChange describes how a commit changed a table
+type TableChange struct {
+	Table        string
+	DataChange   bool
+	SchemaChange bool
+}
+
+// RowDiff is a row changed by a commit. From and To hold the values of the columns before and after the commit, and
+// are empty for added and removed rows respectively
+type RowDiff struct {
+	Type string
+	From map[string]string
+	To   map[string]string
+}
+
+func validateCommitHash(hash string) error {
+	if !commitHashRegex.MatchString(hash) {
+		return fmt.Errorf("'%s' is not a valid commit hash", hash)
+	}
+	return nil
+}
+
+func scanCommits(rows *sql.Rows) ([]Commit, error) {
+	defer rows.Close()
+	commits := []Commit{}
+	for rows.Next() {
+		commit := Commit{}
+		err := rows.Scan(&commit.Hash, &commit.Committer, &commit.Email, &commit.Date, &commit.Message)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read commit: %w", err)
+		}
+		commits = append(commits, commit)
+	}
+	return commits, rows.Err()
+}
+
+// Log returns the most recent commits of the main branch, newest first
+func (db *DB) Log(limit int) ([]Commit, error) {
+	rows, err := db.Query("SELECT commit_hash, committer, email, date, message FROM dolt_log LIMIT ?", limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve commits: %w", err)
+	}
+	return scanCommits(rows)
+}
+
+// GetCommit returns a commit of the main branch
+func (db *DB) GetCommit(hash string) (Commit, error) {
+	if err := validateCommitHash(hash); err != nil {
+		return Commit{}, err
+	}
+	rows, err := db.Query("SELECT commit_hash, committer, email, date, message FROM dolt_log WHERE commit_hash = ?", hash)
+	if err != nil {
+		return Commit{}, fmt.Errorf("failed to retrieve commit '%s': %w", hash, err)
+	}
+	commits, err := scanCommits(rows)
+	if err != nil {
+		return Commit{}, err
+	}
+	if len(commits) == 0 {
+		return Commit{}, fmt.Errorf("commit '%s' not found", hash)
+	}
+	return commits[0], nil
+}
+
+// CommitSignatures returns the signatures stored for the commits, indexed by commit hash. The signatures are not
+// verified
+func (db *DB) CommitSignatures() (map[string][]string, error) {
+	rows, err := db.Query("SELECT tag_hash, tag_name FROM dolt_tags")
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve commit signatures: %w", err)
+	}
+	defer rows.Close()
+	signatures := map[string][]string{}
+	for rows.Next() {
+		var hash, signature string
+		if err := rows.Scan(&hash, &signature); err != nil {
+			return nil, fmt.Errorf("failed to read commit signature: %w", err)
+		}
+		signatures[hash] = append(signatures[hash], signature)
+	}
+	return signatures, rows.Err()
+}
+
+// ChangedTables returns the tables changed by a commit
+func (db *DB) ChangedTables(hash string) ([]TableChange, error) {
+	if err := validateCommitHash(hash); err != nil {
+		return nil, err
+	}
+	rows, err := db.Query("SELECT table_name, data_change, schema_change FROM dolt_diff WHERE commit_hash = ?", hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve tables changed by commit '%s': %w", hash, err)
+	}
+	defer rows.Close()
+
+	changes := []TableChange{}
+	for rows.Next() {
+		change := TableChange{}
+		err := rows.Scan(&change.Table, &change.DataChange, &change.SchemaChange)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read table change: %w", err)
+		}
+		changes = append(changes, change)
+	}
+	return changes, rows.Err()
+}
+
+// DiffTable returns the rows of a table changed by a commit. The values of secret columns are redacted
+func (db *DB) DiffTable(hash string, table string) ([]RowDiff, error) {
+	if err := validateCommitHash(hash); err != nil {
+		return nil, err
+	}
+	diffs, err := db.diffRows(table, hash+"~1", hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff commit '%s': %w", hash, err)
+	}
+	return diffs, nil
+}
+
+// diffRows returns the rows of a table that differ between two revisions, or the revisions of a three dot range
+func (db *DB) diffRows(table string, revisions ...string) ([]RowDiff, error) {
+	args := []interface{}{}
+	placeholders := []string{}
+	for _, revision := range append(revisions, table) {
+		args = append(args, revision)
+		placeholders = append(placeholders, "?")
+	}
+	rows, err := db.Query(fmt.Sprintf("SELECT * FROM DOLT_DIFF(%s)", strings.Join(placeholders, ", ")), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff table '%s': %w", table, err)
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff table '%s': %w", table, err)
+	}
+
+	diffs := []RowDiff{}
+	values := make([]sql.NullString, len(columns))
+	pointers := make([]interface{}, len(columns))
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+	for rows.Next() {
+		err := rows.Scan(pointers...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read diff of table '%s': %w", table, err)
+		}
+
+		diff := RowDiff{From: map[string]string{}, To: map[string]string{}}
+		for i, column := range columns {
+			// the diff also holds the commits and dates of both sides, which are not part of the row
+			if column == "diff_type" {
+				diff.Type = values[i].String
+				continue
+			}
+			side, name, found := strings.Cut(column, "_")
+			if !found || name == "commit" || name == "commit_date" {
+				continue
+			}
+			value := values[i].String
+			if !values[i].Valid {
+				value = "NULL"
+			}
+			if secretColumns[table][name] {
+				value = redactedValue
+			}
+			switch side {
+			case "from":
+				diff.From[name] = value
+			case "to":
+				diff.To[name] = value
+			}
+		}
+
+		switch diff.Type {
+		case DiffAdded:
+			diff.From = map[string]string{}
+		case DiffRemoved:
+			diff.To = map[string]string{}
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs, rows.Err()
+}
+
+// Revert creates a new commit that undoes the changes of a previous commit, and returns the hash of the new commit.
+// Like the other commits made by the local peer, the new commit is signed
+func (db *DB) Revert(hash string) (string, error) {
+	if err := validateCommitHash(hash); err != nil {
+		return "", err
+	}
+
+	// the revert runs on a single connection, so that the commit it creates is the head of the session
+	ctx := context.Background()
+	conn, err := db.DB.DB.Conn(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to revert commit '%s': %w", hash, err)
+	}
+	defer conn.Close()
+
+	_, err = conn.ExecContext(ctx, "CALL DOLT_REVERT('--author', ?, ?)", db.author(), hash)
+	if err != nil {
+		return "", fmt.Errorf("failed to revert commit '%s': %w", hash, err)
+	}
+	var revertHash string
+	err = conn.QueryRowContext(ctx, "SELECT DOLT_HASHOF('HEAD')").Scan(&revertHash)
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve commit that reverted '%s': %w", hash, err)
+	}
+	err = db.signCommit(ctx, conn, revertHash)
+	if err != nil {
+		return "", err
+	}
+	return revertHash, nil
+}
@@ -0,0 +1,117 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Scope decides on which machines a table lives, and if it's replicated to the peers
+type Scope string
+
+const (
+	ScopeGlobal   = Scope("global")
+	ScopeClient   = Scope("client")
+	ScopeInstance = Scope("instance")
+)
+
+// LocalDatabase holds the tables that are not global. It lives next to the replicated database, but it's never
+// synchronised with the peers
+const LocalDatabase = "protos_local"
+
+// models holds all the models, and is used to find the scope of each table
+var models = []any{INSTANCE{}, CLOUD_PROVIDER{}, SSH_KEY{}, APP{}, USER{}, USER_DEVICE{}, DNS_RECORD{}, KEY_ROTATION{}, DATA_KEY{}}
+
+// localSchema holds the statements that create the tables of the local database, indexed by table
+var localSchema = map[string]string{
+	"ssh_keys": `CREATE TABLE IF NOT EXISTS protos_local.ssh_keys (
+		public VARCHAR(255) NOT NULL PRIMARY KEY,
+		private TEXT
+	)`,
+}
+
+// Table describes where the table of a model lives
+type Table struct {
+	Database string
+	Name     string
+	Scope    Scope
+}
+
+// Tables returns the tables of all the models, based on the struct tags of the models. Tables that are not global
+// have to live in the local database
+func Tables() ([]Table, error) {
+	tables := []Table{}
+	for _, model := range models {
+		modelType := reflect.TypeOf(model)
+		field, found := modelType.FieldByName("TableStruct")
+		if !found {
+			return nil, fmt.Errorf("model '%s' is not a table", modelType.Name())
+		}
+
+		table := Table{Scope: Scope(field.Tag.Get("scope"))}
+		var qualified bool
+		table.Database, table.Name, qualified = strings.Cut(field.Tag.Get("sq"), ".")
+		if !qualified {
+			table.Database, table.Name = "", table.Database
+		}
+
+		switch table.Scope {
+		case ScopeGlobal:
+			if table.Database != "" {
+				return nil, fmt.Errorf("global table '%s' can't live in database '%s'", table.Name, table.Database)
+			}
+		case ScopeClient, ScopeInstance:
+			if table.Database != LocalDatabase {
+				return nil, fmt.Errorf("%s table '%s' has to live in the '%s' database", table.Scope, table.Name, LocalDatabase)
+			}
+		default:
+			return nil, fmt.Errorf("model '%s' has invalid scope '%s'", modelType.Name(), table.Scope)
+		}
+		tables = append(tables, table)
+	}
+	return tables, nil
+}
+
+// openLocal creates the local database, together with the tables that belong to the scope of the local machine.
+// Rows of tables that were replicated by older versions are copied from the replicated db, before the migrations
+// drop those tables
+func openLocal(sqlDB *sql.DB, scope Scope) error {
+	tables, err := Tables()
+	if err != nil {
+		return err
+	}
+
+	_, err = sqlDB.Exec(fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s", LocalDatabase))
+	if err != nil {
+		return fmt.Errorf("failed to create local database: %w", err)
+	}
+
+	for _, table := range tables {
+		if table.Scope != scope {
+			continue
+		}
+		statement, found := localSchema[table.Name]
+		if !found {
+			return fmt.Errorf("no schema for local table '%s'", table.Name)
+		}
+		_, err := sqlDB.Exec(statement)
+		if err != nil {
+			return fmt.Errorf("failed to create local table '%s': %w", table.Name, err)
+		}
+
+		var replicated int
+		err = sqlDB.QueryRow("SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ?", table.Name).Scan(&replicated)
+		if err != nil {
+			return fmt.Errorf("failed to check replicated table '%s': %w", table.Name, err)
+		}
+		if replicated == 0 {
+			continue
+		}
+		_, err = sqlDB.Exec(fmt.Sprintf("INSERT IGNORE INTO %s.%s SELECT * FROM %s", LocalDatabase, table.Name, table.Name))
+		if err != nil {
+			return fmt.Errorf("failed to copy replicated table '%s' to the local database: %w", table.Name, err)
+		}
+	}
+	return nil
+}
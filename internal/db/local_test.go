@@ -0,0 +1,21 @@
+package db
+
+import "testing"
+
+func TestTables(t *testing.T) {
+	tables, err := Tables()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tables) != len(models) {
+		t.Fatalf("found %d tables instead of %d", len(tables), len(models))
+	}
+	for _, table := range tables {
+		if table.Scope == ScopeGlobal {
+			continue
+		}
+		if _, found := localSchema[table.Name]; !found {
+			t.Errorf("local table '%s' has no schema", table.Name)
+		}
+	}
+}
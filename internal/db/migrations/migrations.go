@@ -0,0 +1,143 @@
+// Package migrations versions the schema of the replicated database. The applied migrations are recorded in a table
+// that is replicated together with the data, so every peer knows which schema the db uses
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+const versionTable = "schema_versions"
+
+// Migration changes the schema of the db. Migrations are applied in order and have to be idempotent, because a peer
+// might receive the same schema change from another peer before applying it locally
+type Migration struct {
+	Version     int32
+	Description string
+	Up          func(tx *sql.Tx) error
+}
+
+// migrations holds all the migrations, ordered by version. New migrations are only appended
+var migrations = []Migration{
+	{Version: 1, Description: "create the initial tables", Up: createInitialTables},
+	{Version: 2, Description: "add the p2p addresses of the instances", Up: addInstanceMultiaddrs},
+	{Version: 3, Description: "create the table of sealed data keys", Up: createDataKeys},
+	{Version: 4, Description: "drop the tables that moved to the local database", Up: dropLocalTables},
+	{Version: 5, Description: "add the ports and capabilities of the apps", Up: addAppPortsAndCapabilities},
+	{Version: 6, Description: "add the hub of the external devices", Up: addDeviceHub},
+	{Version: 7, Description: "create the table of user defined DNS records", Up: createDNSRecords},
+	{Version: 8, Description: "create the table of key rotations", Up: createKeyRotations},
+	{Version: 9, Description: "move the user devices to their own table", Up: moveUserDevices},
+	{Version: 10, Description: "bind the key rotations to machines and countersign them", Up: addKeyRotationCountersignature},
+	{Version: 11, Description: "add the approvals of the user devices", Up: addDeviceApproval},
+}
+
+// Latest returns the schema version the db has after all the migrations are applied
+func Latest() int32 {
+	return migrations[len(migrations)-1].Version
+}
+
+// Current returns the schema version of the db, or 0 if no migrations were applied
+func Current(db *sql.DB) (int32, error) {
+	var version int32
+	err := db.QueryRow(fmt.Sprintf("SELECT COALESCE(MAX(version), 0) FROM %s", versionTable)).Scan(&version)
+	if err != nil {
+		return 0, fmt.Errorf("failed to retrieve schema version: %w", err)
+	}
+	return version, nil
+}
+
+// Run applies the migrations that are missing from the db, and returns how many were applied. A db that was already
+// migrated by a newer version of protos is refused, since this version can't read or write it safely
+func Run(db *sql.DB) (int, error) {
+	// the version rows don't hold timestamps or other local details, so that peers that apply the same migration
+	// create identical rows, which merge without conflicts
+	_, err := db.Exec(fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (version INT NOT NULL PRIMARY KEY, description VARCHAR(255) NOT NULL)", versionTable))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create schema version table: %w", err)
+	}
+
+	current, err := Current(db)
+	if err != nil {
+		return 0, err
+	}
+	if current > Latest() {
+		return 0, fmt.Errorf("db schema version %d is newer than the supported version %d: protos needs to be upgraded", current, Latest())
+	}
+
+	applied := 0
+	for _, migration := range migrations {
+		if migration.Version <= current {
+			continue
+		}
+		err := apply(db, migration)
+		if err != nil {
+			return applied, fmt.Errorf("failed to apply migration %d (%s): %w", migration.Version, migration.Description, err)
+		}
+		applied++
+	}
+	return applied, nil
+}
+
+// apply runs a migration and records its version in a single transaction
+func apply(db *sql.DB, migration Migration) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = migration.Up(tx)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(fmt.Sprintf("INSERT IGNORE INTO %s (version, description) VALUES (?, ?)", versionTable), migration.Version, migration.Description)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+//
+// Helpers for writing idempotent migrations
+//
+
+// execAll runs a list of statements
+func execAll(tx *sql.Tx, statements ...string) error {
+	for _, statement := range statements {
+		if _, err := tx.Exec(statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// columnExists checks if a table has a column
+func columnExists(tx *sql.Tx, table string, column string) (bool, error) {
+	var count int
+	err := tx.QueryRow("SELECT COUNT(*) FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = ? AND column_name = ?", table, column).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check column '%s' of table '%s': %w", column, table, err)
+	}
+	return count > 0, nil
+}
+
+// addColumn adds a column to a table, unless the column exists already
+func addColumn(tx *sql.Tx, table string, column string, definition string) error {
+	exists, err := columnExists(tx, table, column)
+	if err != nil || exists {
+		return err
+	}
+	_, err = tx.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition))
+	return err
+}
+
+// dropColumn drops a column of a table, if the column exists
+func dropColumn(tx *sql.Tx, table string, column string) error {
+	exists, err := columnExists(tx, table, column)
+	if err != nil || !exists {
+		return err
+	}
+	_, err = tx.Exec(fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", table, column))
+	return err
+}
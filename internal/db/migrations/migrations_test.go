@@ -0,0 +1,14 @@
+package migrations
+
+import "testing"
+
+func TestMigrationsOrdered(t *testing.T) {
+	for i, migration := range migrations {
+		if migration.Version != int32(i+1) {
+			t.Errorf("migration '%s' has version %d instead of %d", migration.Description, migration.Version, i+1)
+		}
+		if migration.Up == nil {
+			t.Errorf("migration %d doesn't have an Up function", migration.Version)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package migrations
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+)
+
+// createInitialTables creates the tables that were used before the schema was versioned. Later changes are made by
+// their own migrations, so that dbs created before the versioning get them too
+func createInitialTables(tx *sql.Tx) error {
+	return execAll(tx,
+		`CREATE TABLE IF NOT EXISTS instances (
+			vm_id VARCHAR(255) NOT NULL PRIMARY KEY,
+			name VARCHAR(255) NOT NULL,
+			ssh_key_seed TEXT,
+			public_key VARCHAR(255),
+			public_ip VARCHAR(64),
+			internal_ip VARCHAR(64),
+			cloud_type VARCHAR(64),
+			cloud_name VARCHAR(255),
+			location VARCHAR(255),
+			network VARCHAR(64),
+			protos_version VARCHAR(64),
+			architecture VARCHAR(64)
+		)`,
+		`CREATE TABLE IF NOT EXISTS cloud_providers (
+			name VARCHAR(255) NOT NULL PRIMARY KEY,
+			type VARCHAR(64),
+			auth JSON
+		)`,
+		`CREATE TABLE IF NOT EXISTS ssh_keys (
+			public VARCHAR(255) NOT NULL PRIMARY KEY,
+			private TEXT
+		)`,
+		`CREATE TABLE IF NOT EXISTS apps (
+			id VARCHAR(255) NOT NULL PRIMARY KEY,
+			name VARCHAR(255) NOT NULL,
+			installer_ref VARCHAR(255),
+			instance_name VARCHAR(255),
+			desired_status VARCHAR(64),
+			ip VARCHAR(64),
+			persistence BOOLEAN
+		)`,
+		`CREATE TABLE IF NOT EXISTS users (
+			username VARCHAR(255) NOT NULL PRIMARY KEY,
+			name VARCHAR(255),
+			is_disabled BOOLEAN,
+			devices JSON
+		)`,
+		`CREATE TABLE IF NOT EXISTS user_devices (
+			id VARCHAR(255) NOT NULL PRIMARY KEY,
+			name VARCHAR(255) NOT NULL,
+			public_key VARCHAR(255),
+			network VARCHAR(64)
+		)`,
+	)
+}
+
+// addInstanceMultiaddrs adds the libp2p listen addresses of the instances
+func addInstanceMultiaddrs(tx *sql.Tx) error {
+	return addColumn(tx, "instances", "multiaddrs", "TEXT")
+}
+
+// createDataKeys creates the table that holds the data key, sealed to each device of the user
+func createDataKeys(tx *sql.Tx) error {
+	return execAll(tx,
+		`CREATE TABLE IF NOT EXISTS data_keys (
+			public_key VARCHAR(255) NOT NULL PRIMARY KEY,
+			sealed_key TEXT NOT NULL
+		)`,
+	)
+}
+
+// dropLocalTables drops the tables that are not replicated anymore. Their rows are copied to the local database when
+// the db is opened, before the migrations run
+func dropLocalTables(tx *sql.Tx) error {
+	return execAll(tx, `DROP TABLE IF EXISTS ssh_keys`)
+}
+
+// addAppPortsAndCapabilities adds the ports exposed by the apps and the capabilities they hold
+func addAppPortsAndCapabilities(tx *sql.Tx) error {
+	err := addColumn(tx, "apps", "ports", "TEXT")
+	if err != nil {
+		return err
+	}
+	return addColumn(tx, "apps", "capabilities", "TEXT")
+}
+
+// addDeviceHub adds the instance that external devices use to reach the network
+func addDeviceHub(tx *sql.Tx) error {
+	return addColumn(tx, "user_devices", "hub", "VARCHAR(255)")
+}
+
+// createDNSRecords creates the table of user defined DNS records
+func createDNSRecords(tx *sql.Tx) error {
+	return execAll(tx,
+		`CREATE TABLE IF NOT EXISTS dns_records (
+			name VARCHAR(255) NOT NULL,
+			type VARCHAR(16) NOT NULL,
+			value VARCHAR(255) NOT NULL,
+			PRIMARY KEY (name, type, value)
+		)`,
+	)
+}
+
+// createKeyRotations creates the table that announces the key rotations of the peers
+func createKeyRotations(tx *sql.Tx) error {
+	return execAll(tx,
+		`CREATE TABLE IF NOT EXISTS key_rotations (
+			old_public_key VARCHAR(255) NOT NULL PRIMARY KEY,
+			new_public_key VARCHAR(255) NOT NULL,
+			signature TEXT,
+			activate_at DATETIME,
+			expires_at DATETIME
+		)`,
+	)
+}
+
+// legacyDevice is a device as it was stored in the devices column of the users table
+type legacyDevice struct {
+	Name      string `json:"name"`
+	PublicKey string `json:"publickey"`
+	Network   string `json:"network"`
+	MachineID string `json:"machineid"`
+}
+
+// moveUserDevices copies the devices stored in the users table to the user_devices table, and drops the old column
+func moveUserDevices(tx *sql.Tx) error {
+	exists, err := columnExists(tx, "users", "devices")
+	if err != nil || !exists {
+		return err
+	}
+
+	rows, err := tx.Query("SELECT devices FROM users WHERE devices IS NOT NULL")
+	if err != nil {
+		return fmt.Errorf("failed to retrieve user devices: %w", err)
+	}
+	encodedDevices := []string{}
+	for rows.Next() {
+		var encoded string
+		if err := rows.Scan(&encoded); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to read user devices: %w", err)
+		}
+		encodedDevices = append(encodedDevices, encoded)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to retrieve user devices: %w", err)
+	}
+
+	for _, encoded := range encodedDevices {
+		devices := []legacyDevice{}
+		if err := json.Unmarshal([]byte(encoded), &devices); err != nil {
+			return fmt.Errorf("failed to decode user devices: %w", err)
+		}
+		for _, device := range devices {
+			if device.MachineID == "" {
+				continue
+			}
+			_, err := tx.Exec("INSERT IGNORE INTO user_devices (id, name, public_key, network) VALUES (?, ?, ?, ?)", device.MachineID, device.Name, device.PublicKey, device.Network)
+			if err != nil {
+				return fmt.Errorf("failed to move device '%s': %w", device.Name, err)
+			}
+		}
+	}
+
+	return dropColumn(tx, "users", "devices")
+}
+
+// addKeyRotationCountersignature binds the key rotations to a machine, and adds the signature created using the new
+// key. Rotations published before don't have either, and are ignored from now on
+func addKeyRotationCountersignature(tx *sql.Tx) error {
+	err := addColumn(tx, "key_rotations", "machine_id", "VARCHAR(255)")
+	if err != nil {
+		return err
+	}
+	return addColumn(tx, "key_rotations", "new_signature", "TEXT")
+}
+
+// addDeviceApproval adds the signature of the device that approved a new device. Only approved devices receive the
+// data key
+func addDeviceApproval(tx *sql.Tx) error {
+	for _, column := range []string{"approved_key", "approved_by", "approval"} {
+		err := addColumn(tx, "user_devices", column, "TEXT")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
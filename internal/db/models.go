@@ -2,13 +2,22 @@ package db
 
 import "github.com/bokwoon95/sq"
 
+// The replicated tables are created and changed by the migrations package. Every change to the global models below
+// requires a new migration, while the tables of the other scopes are created in the local database by local.go.
+//
+// Every model has a scope tag, which decides where its table lives:
+// - global tables are replicated to all the peers
+// - client tables only exist on the devices of the user, in the local database
+// - instance tables only exist on the instances, in the local database
+
 type INSTANCE struct {
-	sq.TableStruct `sq:"instances"`
+	sq.TableStruct `sq:"instances" scope:"global"`
 	VM_ID          sq.StringField
 	NAME           sq.StringField
-	SSH_KEY_SEED   sq.StringField // private SSH key stored only on the client
+	SSH_KEY_SEED   sq.StringField // private SSH key, encrypted using the data key
 	PUBLIC_KEY     sq.StringField // ed25519 public key
 	PUBLIC_IP      sq.StringField // this can be a public or private IP, depending on where the device is located
+	MULTIADDRS     sq.StringField // comma separated list of libp2p listen addresses
 	INTERNAL_IP    sq.StringField // this is the wireguard IP
 	CLOUD_TYPE     sq.StringField
 	CLOUD_NAME     sq.StringField
@@ -19,20 +28,20 @@ type INSTANCE struct {
 }
 
 type CLOUD_PROVIDER struct {
-	sq.TableStruct `sq:"cloud_providers"`
+	sq.TableStruct `sq:"cloud_providers" scope:"global"`
 	NAME           sq.StringField
 	TYPE           sq.StringField
-	AUTH           sq.JSONField
+	AUTH           sq.JSONField // credentials, encrypted using the data key and stored as a JSON string
 }
 
 type SSH_KEY struct {
-	sq.TableStruct `sq:"ssh_keys"`
+	sq.TableStruct `sq:"protos_local.ssh_keys" scope:"client"`
 	PRIVATE        sq.StringField
 	PUBLIC         sq.StringField
 }
 
 type APP struct {
-	sq.TableStruct `sq:"apps"`
+	sq.TableStruct `sq:"apps" scope:"global"`
 	NAME           sq.StringField
 	ID             sq.StringField
 	INSTALLER_REF  sq.StringField
@@ -40,20 +49,49 @@ type APP struct {
 	DESIRED_STATUS sq.StringField
 	IP             sq.StringField
 	PERSISTENCE    sq.BooleanField
+	PORTS          sq.StringField // comma separated list of published ports, in the [name:]number/protocol format
+	CAPABILITIES   sq.StringField // comma separated list of capability names granted to the app
 }
 
 type USER struct {
-	sq.TableStruct `sq:"users"`
+	sq.TableStruct `sq:"users" scope:"global"`
 	USERNAME       sq.StringField
 	NAME           sq.StringField
 	IS_DISABLED    sq.BooleanField
-	DEVICES        sq.ArrayField
 }
 
 type USER_DEVICE struct {
-	sq.TableStruct `sq:"user_devices"`
+	sq.TableStruct `sq:"user_devices" scope:"global"`
 	ID             sq.StringField
 	NAME           sq.StringField
 	PUBLIC_KEY     sq.StringField
 	NETWORK        sq.StringField
+	HUB            sq.StringField // instance that routes the traffic of an external device
+	APPROVED_KEY   sq.StringField // public key of the device at the time it was approved
+	APPROVED_BY    sq.StringField // public key of the device that approved it
+	APPROVAL       sq.StringField
+}
+
+type DNS_RECORD struct {
+	sq.TableStruct `sq:"dns_records" scope:"global"`
+	NAME           sq.StringField // name relative to the internal domain, can start with a * label
+	TYPE           sq.StringField // A, AAAA or CNAME
+	VALUE          sq.StringField
+}
+
+type KEY_ROTATION struct {
+	sq.TableStruct `sq:"key_rotations" scope:"global"`
+	MACHINE_ID     sq.StringField // device machine ID or instance name that the rotation belongs to
+	OLD_PUBLIC_KEY sq.StringField // ed25519 public key that is being replaced
+	NEW_PUBLIC_KEY sq.StringField // ed25519 public key that replaces the old one
+	SIGNATURE      sq.StringField // signature of the rotation, created using the old key
+	NEW_SIGNATURE  sq.StringField // countersignature of the rotation, created using the new key
+	ACTIVATE_AT    sq.TimeField   // time when the new key becomes active
+	EXPIRES_AT     sq.TimeField   // time until the old key is still accepted
+}
+
+type DATA_KEY struct {
+	sq.TableStruct `sq:"data_keys" scope:"global"`
+	PUBLIC_KEY     sq.StringField // ed25519 public key of the device the data key is sealed to
+	SEALED_KEY     sq.StringField // data key, sealed using the curve25519 form of the public key
 }
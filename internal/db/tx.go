@@ -0,0 +1,113 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// Tx is a transaction of the db. It implements Querier, so it can be used with the same helpers as the db
+type Tx struct {
+	tx *sql.Tx
+	db *DB
+}
+
+// ExecContext runs a statement in the transaction
+func (tx *Tx) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return tx.tx.ExecContext(ctx, query, args...)
+}
+
+// QueryContext runs a query in the transaction
+func (tx *Tx) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return tx.tx.QueryContext(ctx, query, args...)
+}
+
+// PrepareContext prepares a statement in the transaction
+func (tx *Tx) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return tx.tx.PrepareContext(ctx, query)
+}
+
+// commit saves the changes made in the transaction in a single commit, authored and signed by the local peer.
+// Transactions that didn't change anything don't create a commit
+func (tx *Tx) commit(ctx context.Context, message string) error {
+	var changes int
+	err := tx.tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM dolt_status").Scan(&changes)
+	if err != nil {
+		return fmt.Errorf("failed to check changes: %w", err)
+	}
+	if changes > 0 {
+		var hash string
+		err = tx.tx.QueryRowContext(ctx, "CALL DOLT_COMMIT('-A', '-m', ?, '--author', ?)", message, tx.db.author()).Scan(&hash)
+		if err != nil {
+			return fmt.Errorf("failed to commit changes: %w", err)
+		}
+		err = tx.db.signCommit(ctx, tx.tx, hash)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.tx.Commit()
+}
+
+// author returns the commit author of the local peer. Like the commits made by the peers, it uses the peer ID
+func (db *DB) author() string {
+	id := db.signer.GetID()
+	return fmt.Sprintf("%s <%s@protos>", id, id)
+}
+
+// signCommit signs a commit using the signer of the db, which is also the one that signs the commits made by
+// ExecAndCommit. The signature is encoded so it's a valid tag name, and is stored as a tag of the commit, together
+// with the public key that verifies it
+func (db *DB) signCommit(ctx context.Context, e sqlExecutor, hash string) error {
+	signature, err := db.signer.Sign(hash)
+	if err != nil {
+		return fmt.Errorf("failed to sign commit '%s': %w", hash, err)
+	}
+	_, err = e.ExecContext(ctx, "CALL DOLT_TAG('-m', ?, '--author', ?, ?, ?)", db.signer.PublicKey(), db.author(), signature, hash)
+	if err != nil {
+		return fmt.Errorf("failed to store signature of commit '%s': %w", hash, err)
+	}
+	return nil
+}
+
+// beginTx starts a transaction on the branch used by the db. The caller holds the lock returned by lockExecutor until
+// the transaction finishes
+func (db *DB) beginTx(ctx context.Context) (*sql.Tx, error) {
+	if conn, ok := db.executor().(*sql.Conn); ok {
+		return conn.BeginTx(ctx, nil)
+	}
+	return db.DB.DB.BeginTx(ctx, nil)
+}
+
+// Tx runs fn in a transaction. If fn succeeds, all its changes are saved in a single commit with the provided message,
+// otherwise none of them are. Operations that write multiple rows use it so they don't leave partial state behind.
+// On a staged view with an open changeset, the commit is made on the changeset branch. The transactions of staged
+// views share the changeset connection, so they run one at a time, and fn can only use the db through tx
+func (db *DB) Tx(message string, fn func(tx *Tx) error) error {
+	ctx := context.Background()
+	unlock := db.lockExecutor()
+	defer unlock()
+
+	sqlTx, err := db.beginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	tx := &Tx{tx: sqlTx, db: db}
+
+	err = fn(tx)
+	if err != nil {
+		if rollbackErr := sqlTx.Rollback(); rollbackErr != nil {
+			logger.Errorf("Failed to roll back transaction '%s': %s", message, rollbackErr.Error())
+		}
+		return err
+	}
+
+	err = tx.commit(ctx, message)
+	if err != nil {
+		if rollbackErr := sqlTx.Rollback(); rollbackErr != nil && rollbackErr != sql.ErrTxDone {
+			logger.Errorf("Failed to roll back transaction '%s': %s", message, rollbackErr.Error())
+		}
+		return fmt.Errorf("failed to commit transaction '%s': %w", message, err)
+	}
+	return nil
+}
@@ -1,9 +1,11 @@
 package dns
 
 import (
+	"fmt"
 	"net"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/miekg/dns"
 	"github.com/pkg/errors"
@@ -14,104 +16,538 @@ import (
 
 var log = util.GetLogger("dns")
 
-var domainsMap map[string]string = map[string]string{}
+const (
+	defaultTTL    = 60
+	nameServerTTL = 3600
+	reverseSuffix = ".in-addr.arpa."
+	maxCNAMEDepth = 8
+)
 
-func (h *handler) localResolve(w dns.ResponseWriter, r *dns.Msg) {
-	log.Debugf("Performing local DNS resolve for '%s'", r.Question[0].Name)
-	msg := &dns.Msg{}
-	msg.SetReply(r)
+// appProvider returns the apps that are resolved by the DNS server. The apps are retrieved from the replicated db,
+// so they include the apps from all the instances
+type appProvider interface {
+	GetAll() ([]app.App, error)
+	GetRunning() ([]app.App, error)
+}
+
+// recordProvider returns the user defined records
+type recordProvider interface {
+	GetRecords() ([]Record, error)
+}
+
+// reverseZone returns the reverse lookup zone for an IPv4 network. Only networks with a prefix length that is a
+// multiple of 8 are supported
+func reverseZone(network string) (string, error) {
+	_, ipnet, err := net.ParseCIDR(network)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse network '%s': %w", network, err)
+	}
+	ones, _ := ipnet.Mask.Size()
+	ip := ipnet.IP.To4()
+	if ip == nil || ones%8 != 0 {
+		return "", fmt.Errorf("can't create reverse zone for network '%s'", network)
+	}
+
+	labels := []string{}
+	for i := ones/8 - 1; i >= 0; i-- {
+		labels = append(labels, strconv.Itoa(int(ip[i])))
+	}
+	return strings.Join(labels, ".") + reverseSuffix, nil
+}
+
+// reverseNameToIP converts a name from a reverse zone to the IP address it refers to
+func reverseNameToIP(name string) net.IP {
+	labels := dns.SplitDomainName(strings.TrimSuffix(name, reverseSuffix))
+	if len(labels) != 4 {
+		return nil
+	}
+	for i, j := 0, len(labels)-1; i < j; i, j = i+1, j-1 {
+		labels[i], labels[j] = labels[j], labels[i]
+	}
+	return net.ParseIP(strings.Join(labels, ".")).To4()
+}
+
+// addressRecords returns the A or AAAA records for an IP, depending on its family and the query type
+func addressRecords(name string, ip net.IP, qtype uint16) []dns.RR {
+	if ip == nil {
+		return []dns.RR{}
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		if qtype == dns.TypeA || qtype == dns.TypeANY {
+			return []dns.RR{&dns.A{Hdr: dns.RR_Header{Name: name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: defaultTTL}, A: ip4}}
+		}
+		return []dns.RR{}
+	}
+	if qtype == dns.TypeAAAA || qtype == dns.TypeANY {
+		return []dns.RR{&dns.AAAA{Hdr: dns.RR_Header{Name: name, Rrtype: dns.TypeAAAA, Class: dns.ClassINET, Ttl: defaultTTL}, AAAA: ip}}
+	}
+	return []dns.RR{}
+}
+
+// srvLabels returns the first two labels of the SRV record for an app port, in the _service._protocol format. Ports
+// without a name use the port number as the service
+func srvLabels(port util.Port) []string {
+	service := port.Name
+	if service == "" {
+		service = strconv.Itoa(port.Nr)
+	}
+	return []string{"_" + strings.ToLower(service), "_" + strings.ToLower(string(port.Type))}
+}
+
+// answer holds the records found for a query. A name that exists but has no records of the requested type results
+// in an empty answer, while a name that doesn't exist results in NXDOMAIN
+type answer struct {
+	found   bool
+	records []dns.RR
+	extra   []dns.RR
+}
+
+type handler struct {
+	listenAddr   string
+	forwarder    *forwarder
+	zone         string
+	reverseZones []string
+	records      map[string]net.IP
+	serial       uint32
+	healthAware  bool
+	appManager   appProvider
+	userRecords  recordProvider
+}
+
+// appFQDN returns the name that uniquely identifies an app in the mesh
+func (h *handler) appFQDN(app app.App) string {
+	return strings.ToLower(app.Name) + "." + strings.ToLower(app.InstanceName) + "." + h.zone
+}
+
+// getApps returns the apps that can be resolved. When the answers are health aware, the apps that are not running
+// are omitted
+func (h *handler) getApps() ([]app.App, error) {
+	var apps []app.App
+	var err error
+	if h.healthAware {
+		apps, err = h.appManager.GetRunning()
+	} else {
+		apps, err = h.appManager.GetAll()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve apps: %w", err)
+	}
+	return apps, nil
+}
+
+func (h *handler) nameServer() string {
+	return "protos." + h.zone
+}
+
+func (h *handler) soa(zone string) dns.RR {
+	return &dns.SOA{
+		Hdr:     dns.RR_Header{Name: zone, Rrtype: dns.TypeSOA, Class: dns.ClassINET, Ttl: nameServerTTL},
+		Ns:      h.nameServer(),
+		Mbox:    "hostmaster." + h.zone,
+		Serial:  h.serial,
+		Refresh: 3600,
+		Retry:   600,
+		Expire:  86400,
+		Minttl:  defaultTTL,
+	}
+}
+
+// apexAnswer answers the queries for the apex of the authoritative zones
+func (h *handler) apexAnswer(zone string, qtype uint16) answer {
+	ans := answer{found: true}
+	if qtype == dns.TypeSOA || qtype == dns.TypeANY {
+		ans.records = append(ans.records, h.soa(zone))
+	}
+	if qtype == dns.TypeNS || qtype == dns.TypeANY {
+		ans.records = append(ans.records, &dns.NS{
+			Hdr: dns.RR_Header{Name: zone, Rrtype: dns.TypeNS, Class: dns.ClassINET, Ttl: nameServerTTL},
+			Ns:  h.nameServer(),
+		})
+		ans.extra = append(ans.extra, addressRecords(h.nameServer(), h.records[h.nameServer()], dns.TypeA)...)
+	}
+	return ans
+}
+
+// lookup finds the records for a name in the internal domain. The user defined records take precedence over the
+// apps, except for the wildcard records, which are only used for names that don't match anything else
+func (h *handler) lookup(name string, qtype uint16) (answer, error) {
+	return h.resolve(name, qtype, 0)
+}
+
+func (h *handler) resolve(name string, qtype uint16, depth int) (answer, error) {
+	if ip, found := h.records[name]; found {
+		return answer{found: true, records: addressRecords(name, ip, qtype)}, nil
+	}
+
+	records, err := h.userRecords.GetRecords()
+	if err != nil {
+		return answer{}, err
+	}
+
+	matched := []Record{}
+	for _, record := range records {
+		if !record.IsWildcard() && record.fqdn(h.zone) == name {
+			matched = append(matched, record)
+		}
+	}
+
+	if name == h.zone {
+		ans := h.apexAnswer(h.zone, qtype)
+		recordAns, err := h.recordAnswer(name, matched, qtype, depth)
+		if err != nil {
+			return answer{}, err
+		}
+		ans.records = append(ans.records, recordAns.records...)
+		return ans, nil
+	}
+
+	if len(matched) > 0 {
+		return h.recordAnswer(name, matched, qtype, depth)
+	}
+
+	ans, err := h.appLookup(name, qtype)
+	if err != nil || ans.found {
+		return ans, err
+	}
+
+	// the closest wildcard wins, and all its records are returned
+	closest := ""
+	for _, record := range records {
+		if record.IsWildcard() && record.matches(name, h.zone) && len(record.Name) > len(closest) {
+			closest = record.Name
+		}
+	}
+	for _, record := range records {
+		if closest != "" && record.Name == closest {
+			matched = append(matched, record)
+		}
+	}
+	if len(matched) > 0 {
+		return h.recordAnswer(name, matched, qtype, depth)
+	}
+
+	return answer{}, nil
+}
+
+// recordAnswer returns the records for a name, based on the matching user defined records. CNAME targets in the
+// internal domain are resolved as well, so that clients don't need to query them separately
+func (h *handler) recordAnswer(name string, records []Record, qtype uint16, depth int) (answer, error) {
+	ans := answer{found: true}
+	for _, record := range records {
+		if record.Type != RecordCNAME {
+			ans.records = append(ans.records, addressRecords(name, net.ParseIP(record.Value), qtype)...)
+			continue
+		}
+
+		target := record.target(h.zone)
+		ans.records = append(ans.records, &dns.CNAME{
+			Hdr:    dns.RR_Header{Name: name, Rrtype: dns.TypeCNAME, Class: dns.ClassINET, Ttl: defaultTTL},
+			Target: target,
+		})
+		if qtype == dns.TypeCNAME || !dns.IsSubDomain(h.zone, target) {
+			continue
+		}
+		if depth >= maxCNAMEDepth {
+			log.Warnf("Not following CNAME '%s' for '%s': too many levels of indirection", target, name)
+			continue
+		}
+		targetAns, err := h.resolve(target, qtype, depth+1)
+		if err != nil {
+			return answer{}, err
+		}
+		ans.records = append(ans.records, targetAns.records...)
+		ans.extra = append(ans.extra, targetAns.extra...)
+	}
+	return ans, nil
+}
+
+// appLookup finds the records for an app. Apps are resolved as <app>.<instance>.<domain>, or as <app>.<domain>, which
+// returns the apps with that name from all the instances. The SRV records for the app ports use the same names,
+// prefixed by _service._protocol
+func (h *handler) appLookup(name string, qtype uint16) (answer, error) {
+	labels := dns.SplitDomainName(strings.TrimSuffix(name, "."+h.zone))
+	service := []string{}
+	if len(labels) > 2 && strings.HasPrefix(labels[0], "_") && strings.HasPrefix(labels[1], "_") {
+		service = labels[:2]
+		labels = labels[2:]
+	}
+	if len(labels) != 1 && len(labels) != 2 {
+		return answer{}, nil
+	}
+
+	apps, err := h.getApps()
+	if err != nil {
+		return answer{}, err
+	}
+
+	matched := []app.App{}
+	for _, app := range apps {
+		if strings.ToLower(app.Name) != labels[0] {
+			continue
+		}
+		if len(labels) == 2 && strings.ToLower(app.InstanceName) != labels[1] {
+			continue
+		}
+		matched = append(matched, app)
+	}
 
-	switch r.Question[0].Qtype {
-	case dns.TypeA:
-		msg.Authoritative = true
-		domain := msg.Question[0].Name
-		address, ok := domainsMap[domain]
-		domainParts := strings.Split(domain, ".")
-
-		if ok {
-			msg.Answer = append(msg.Answer, &dns.A{
-				Hdr: dns.RR_Header{Name: domain, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
-				A:   net.ParseIP(address),
+	if len(service) > 0 {
+		return h.srvAnswer(name, service, matched, qtype), nil
+	}
+
+	ans := answer{found: len(matched) > 0}
+	for _, app := range matched {
+		ans.records = append(ans.records, addressRecords(name, app.IP, qtype)...)
+		if qtype == dns.TypeTXT || qtype == dns.TypeANY {
+			ans.records = append(ans.records, &dns.TXT{
+				Hdr: dns.RR_Header{Name: name, Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: defaultTTL},
+				Txt: []string{"id=" + app.ID, "instance=" + app.InstanceName},
 			})
-		} else if app, err := h.appManager.Get(domainParts[0]); err == nil {
-			msg.Answer = append(msg.Answer, &dns.A{
-				Hdr: dns.RR_Header{Name: domain, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
-				A:   app.IP,
+		}
+	}
+	return ans, nil
+}
+
+// srvAnswer returns the SRV records for the app ports that match the _service._protocol labels. The targets point to
+// the instance specific name of each app
+func (h *handler) srvAnswer(name string, service []string, apps []app.App, qtype uint16) answer {
+	ans := answer{}
+	for _, app := range apps {
+		for _, port := range app.Ports {
+			labels := srvLabels(port)
+			if labels[0] != service[0] || labels[1] != service[1] {
+				continue
+			}
+			ans.found = true
+			if qtype != dns.TypeSRV && qtype != dns.TypeANY {
+				continue
+			}
+			target := h.appFQDN(app)
+			ans.records = append(ans.records, &dns.SRV{
+				Hdr:      dns.RR_Header{Name: name, Rrtype: dns.TypeSRV, Class: dns.ClassINET, Ttl: defaultTTL},
+				Priority: 0,
+				Weight:   0,
+				Port:     uint16(port.Nr),
+				Target:   target,
+			})
+			ans.extra = append(ans.extra, addressRecords(target, app.IP, dns.TypeA)...)
+			ans.extra = append(ans.extra, addressRecords(target, app.IP, dns.TypeAAAA)...)
+		}
+	}
+	return ans
+}
+
+// reverseLookup finds the PTR records for a name in one of the mesh reverse zones
+func (h *handler) reverseLookup(zone string, name string, qtype uint16) (answer, error) {
+	if name == zone {
+		return h.apexAnswer(zone, qtype), nil
+	}
+
+	ip := reverseNameToIP(name)
+	if ip == nil {
+		return answer{}, nil
+	}
+
+	ptrNames := []string{}
+	for recordName, recordIP := range h.records {
+		if recordIP.Equal(ip) {
+			ptrNames = append(ptrNames, recordName)
+		}
+	}
+
+	apps, err := h.appManager.GetAll()
+	if err != nil {
+		return answer{}, fmt.Errorf("failed to retrieve apps: %w", err)
+	}
+	for _, app := range apps {
+		if app.IP.Equal(ip) {
+			ptrNames = append(ptrNames, h.appFQDN(app))
+		}
+	}
+
+	records, err := h.userRecords.GetRecords()
+	if err != nil {
+		return answer{}, err
+	}
+	for _, record := range records {
+		if record.Type != RecordCNAME && !record.IsWildcard() && net.ParseIP(record.Value).Equal(ip) {
+			ptrNames = append(ptrNames, record.fqdn(h.zone))
+		}
+	}
+
+	if len(ptrNames) == 0 {
+		return answer{}, nil
+	}
+
+	ans := answer{found: true}
+	if qtype == dns.TypePTR || qtype == dns.TypeANY {
+		for _, ptrName := range ptrNames {
+			ans.records = append(ans.records, &dns.PTR{
+				Hdr: dns.RR_Header{Name: name, Rrtype: dns.TypePTR, Class: dns.ClassINET, Ttl: defaultTTL},
+				Ptr: ptrName,
 			})
 		}
 	}
+	return ans, nil
+}
+
+// localResolve answers authoritatively for the internal domain and the mesh reverse zones
+func (h *handler) localResolve(w dns.ResponseWriter, r *dns.Msg, zone string, lookup func(string, uint16) (answer, error)) {
+	question := r.Question[0]
+	name := strings.ToLower(dns.Fqdn(question.Name))
+	log.Debugf("Performing local DNS resolve for '%s'(%s)", name, dns.TypeToString[question.Qtype])
+
+	msg := &dns.Msg{}
+	msg.SetReply(r)
+	msg.Authoritative = true
+
+	ans, err := lookup(name, question.Qtype)
+	if err != nil {
+		log.Errorf("Failed to resolve '%s': %s", name, err.Error())
+		msg.SetRcode(r, dns.RcodeServerFailure)
+		w.WriteMsg(msg)
+		return
+	}
+
+	if !ans.found {
+		msg.SetRcode(r, dns.RcodeNameError)
+	}
+	msg.Answer = ans.records
+	msg.Extra = ans.extra
+	if len(msg.Answer) == 0 {
+		// the SOA record allows clients to cache the negative answer
+		msg.Ns = []dns.RR{h.soa(zone)}
+	}
 
 	w.WriteMsg(msg)
 }
 
 func (h *handler) remoteResolve(w dns.ResponseWriter, r *dns.Msg) {
-	log.Debugf("Performing external DNS resolve @ '%s' for '%s'", h.dnsServer, r.Question[0].Name)
-	c := &dns.Client{Net: "udp"}
-	resp, _, err := c.Exchange(r, h.dnsServer)
+	log.Debugf("Performing external DNS resolve for '%s'", r.Question[0].Name)
+	resp, err := h.forwarder.Forward(r)
 	if err != nil {
 		log.Errorf("Failed to resolve '%s': %s", r.Question[0].Name, err.Error())
 		dns.HandleFailed(w, r)
 		return
 	}
-	w.WriteMsg(resp)
 
-}
-
-type handler struct {
-	listenAddr string
-	dnsServer  string
-	appManager *app.Manager
+	// responses that don't fit in the UDP buffer of the client are truncated, so that the client retries over TCP
+	if _, ok := w.RemoteAddr().(*net.UDPAddr); ok {
+		size := dns.MinMsgSize
+		if opt := r.IsEdns0(); opt != nil {
+			size = int(opt.UDPSize())
+		}
+		resp.Truncate(size)
+	}
+	w.WriteMsg(resp)
 }
 
 func (h *handler) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
-	domainl := strings.TrimSuffix(r.Question[0].Name, ".")
-	domainlParts := strings.Split(domainl, ".")
-
-	domainq := strings.TrimSuffix(r.Question[0].Name, ".")
-	domainqParts := strings.Split(domainq, ".")
-	if len(domainqParts) == 3 && domainqParts[2] == domainlParts[2] && domainqParts[1] == domainlParts[1] {
-		h.localResolve(w, r)
-	} else if h.dnsServer != "" {
-		h.remoteResolve(w, r)
-	} else {
+	if len(r.Question) != 1 {
 		msg := &dns.Msg{}
-		msg.SetReply(r)
+		msg.SetRcode(r, dns.RcodeFormatError)
 		w.WriteMsg(msg)
+		return
 	}
-}
 
-var srv *dns.Server
+	name := strings.ToLower(dns.Fqdn(r.Question[0].Name))
+	if dns.IsSubDomain(h.zone, name) {
+		h.localResolve(w, r, h.zone, h.lookup)
+		return
+	}
 
-// StartServer starts a DNS server used for resolving internal Protos addresses
-func StartServer(internalIP string, port int, dnsServer string, domain string, appManager *app.Manager) func() error {
-	log.Infof("Starting DNS server. Listening internally on '%s:%d' for domain '%s'", internalIP, port, domain)
-	if dnsServer != "" {
-		log.Debugf("Forwarding external DNS queries to '%s'", dnsServer)
+	for _, zone := range h.reverseZones {
+		if dns.IsSubDomain(zone, name) {
+			h.localResolve(w, r, zone, func(name string, qtype uint16) (answer, error) {
+				return h.reverseLookup(zone, name, qtype)
+			})
+			return
+		}
+	}
+
+	if h.forwarder != nil {
+		h.remoteResolve(w, r)
+		return
+	}
+
+	// queries outside the internal domain are refused when there is no server to forward them to
+	msg := &dns.Msg{}
+	msg.SetRcode(r, dns.RcodeRefused)
+	w.WriteMsg(msg)
+}
+
+// newHandler creates a handler that is authoritative for the internal domain and the reverse zones of the mesh network
+func newHandler(internalIP string, upstreams []string, domain string, meshNetwork string, healthAware bool, appManager appProvider, userRecords recordProvider) (*handler, error) {
+	zone := strings.ToLower(dns.Fqdn(domain))
+	h := &handler{
+		listenAddr:  internalIP,
+		zone:        zone,
+		records:     map[string]net.IP{},
+		serial:      uint32(time.Now().Unix()),
+		healthAware: healthAware,
+		appManager:  appManager,
+		userRecords: userRecords,
 	}
 
 	// adding the IP address used for the internal protos domain
-	// ToDo: improve this
-	domainsMap["protos."+domain+"."] = internalIP
+	h.records[h.nameServer()] = net.ParseIP(internalIP)
 
-	srv = &dns.Server{Addr: internalIP + ":" + strconv.Itoa(port), Net: "udp"}
-	srv.Handler = &handler{listenAddr: internalIP, dnsServer: dnsServer, appManager: appManager}
-	go func() {
-		if err := srv.ListenAndServe(); err != nil {
-			log.Fatalf("Failed to set udp listener %s\n", err.Error())
+	meshReverseZone, err := reverseZone(meshNetwork)
+	if err != nil {
+		log.Errorf("Reverse lookups are not available: %s", err.Error())
+	} else {
+		h.reverseZones = append(h.reverseZones, meshReverseZone)
+	}
+
+	if len(upstreams) > 0 {
+		h.forwarder, err = newForwarder(upstreams)
+		if err != nil {
+			return nil, err
 		}
-	}()
+	}
+	return h, nil
+}
+
+var servers []*dns.Server
+
+// StartServer starts a DNS server used for resolving internal Protos addresses. It listens on both UDP and TCP, and
+// forwards the external queries to the upstream servers, if any are provided. With health aware answers, only the apps
+// that are running are resolved. Reverse lookups are answered for the addresses of the provided mesh network
+func StartServer(internalIP string, port int, upstreams []string, domain string, meshNetwork string, healthAware bool, appManager *app.Manager, recordManager *RecordManager) (func() error, error) {
+	log.Infof("Starting DNS server. Listening internally on '%s:%d' for domain '%s'", internalIP, port, domain)
+	if len(upstreams) > 0 {
+		log.Debugf("Forwarding external DNS queries to '%s'", strings.Join(upstreams, ", "))
+	}
+
+	handler, err := newHandler(internalIP, upstreams, domain, meshNetwork, healthAware, appManager, recordManager)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start DNS server: %w", err)
+	}
+
+	servers = []*dns.Server{}
+	for _, network := range []string{"udp", "tcp"} {
+		srv := &dns.Server{Addr: net.JoinHostPort(internalIP, strconv.Itoa(port)), Net: network, Handler: handler}
+		servers = append(servers, srv)
+		go func() {
+			if err := srv.ListenAndServe(); err != nil {
+				log.Fatalf("Failed to set %s listener %s\n", srv.Net, err.Error())
+			}
+		}()
+	}
 
 	stopper := func() error {
 		return StopServer()
 	}
-	return stopper
+	return stopper, nil
 }
 
-// StopServer starts a DNS server used for resolving internal Protos addresses
+// StopServer stops the DNS server used for resolving internal Protos addresses
 func StopServer() error {
 	log.Debug("Shutting down DNS server")
-	if err := srv.Shutdown(); err != nil {
-		return errors.Wrap(err, "Something went wrong while shutting down the DNS server")
+	for _, srv := range servers {
+		if err := srv.Shutdown(); err != nil {
+			return errors.Wrap(err, "Something went wrong while shutting down the DNS server")
+		}
 	}
 	return nil
 }
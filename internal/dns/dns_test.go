@@ -0,0 +1,207 @@
+package dns
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+
+	"github.com/protosio/protos/internal/app"
+	"github.com/protosio/protos/internal/util"
+)
+
+type testApps []app.App
+
+func (ta testApps) GetAll() ([]app.App, error) {
+	return ta, nil
+}
+
+func (ta testApps) GetRunning() ([]app.App, error) {
+	running := []app.App{}
+	for _, app := range ta {
+		if app.DesiredStatus == "running" {
+			running = append(running, app)
+		}
+	}
+	return running, nil
+}
+
+type testRecords []Record
+
+func (tr testRecords) GetRecords() ([]Record, error) {
+	return tr, nil
+}
+
+type testResponseWriter struct {
+	dns.ResponseWriter
+	msg *dns.Msg
+}
+
+func (w *testResponseWriter) WriteMsg(msg *dns.Msg) error {
+	w.msg = msg
+	return nil
+}
+
+func query(h *handler, name string, qtype uint16) *dns.Msg {
+	req := &dns.Msg{}
+	req.SetQuestion(name, qtype)
+	w := &testResponseWriter{}
+	h.ServeDNS(w, req)
+	return w.msg
+}
+
+func TestServeDNS(t *testing.T) {
+	apps := testApps{{
+		Name:          "web",
+		ID:            "123",
+		InstanceName:  "node1",
+		DesiredStatus: "running",
+		IP:            net.ParseIP("10.100.1.5"),
+		Ports:         []util.Port{{Name: "http", Nr: 80, Type: util.TCP}, {Nr: 53, Type: util.UDP}},
+	}, {
+		Name:          "web",
+		ID:            "456",
+		InstanceName:  "node2",
+		DesiredStatus: "stopped",
+		IP:            net.ParseIP("10.100.2.5"),
+	}}
+	records := testRecords{
+		{Name: "printer", Type: RecordA, Value: "192.168.1.10"},
+		{Name: "www", Type: RecordCNAME, Value: "web.node1"},
+		{Name: "*.lab", Type: RecordA, Value: "192.168.2.1"},
+	}
+	h, err := newHandler("10.100.1.1", nil, "Protos.Internal", "10.100.0.0/16", false, apps, records)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("A", func(t *testing.T) {
+		resp := query(h, "WEB.protos.internal.", dns.TypeA)
+		if resp.Rcode != dns.RcodeSuccess || len(resp.Answer) != 2 {
+			t.Fatalf("expected an A record for each instance, got %v", resp)
+		}
+		resp = query(h, "web.node2.protos.internal.", dns.TypeA)
+		if len(resp.Answer) != 1 || !resp.Answer[0].(*dns.A).A.Equal(apps[1].IP) {
+			t.Errorf("expected '%s', got %v", apps[1].IP, resp)
+		}
+	})
+
+	t.Run("Health aware", func(t *testing.T) {
+		h.healthAware = true
+		defer func() { h.healthAware = false }()
+		resp := query(h, "web.protos.internal.", dns.TypeA)
+		if len(resp.Answer) != 1 || !resp.Answer[0].(*dns.A).A.Equal(apps[0].IP) {
+			t.Errorf("expected only the running app, got %v", resp)
+		}
+		resp = query(h, "web.node2.protos.internal.", dns.TypeA)
+		if resp.Rcode != dns.RcodeNameError {
+			t.Errorf("expected NXDOMAIN for stopped app, got %v", resp)
+		}
+	})
+
+	t.Run("AAAA without IPv6", func(t *testing.T) {
+		resp := query(h, "web.protos.internal.", dns.TypeAAAA)
+		if resp.Rcode != dns.RcodeSuccess || len(resp.Answer) != 0 || len(resp.Ns) != 1 {
+			t.Errorf("expected NODATA with SOA, got %v", resp)
+		}
+	})
+
+	t.Run("SRV", func(t *testing.T) {
+		resp := query(h, "_http._tcp.web.node1.protos.internal.", dns.TypeSRV)
+		if len(resp.Answer) != 1 || resp.Answer[0].(*dns.SRV).Port != 80 || len(resp.Extra) != 1 {
+			t.Errorf("expected SRV record for port 80, got %v", resp)
+		}
+		resp = query(h, "_53._udp.web.protos.internal.", dns.TypeSRV)
+		if len(resp.Answer) != 1 || resp.Answer[0].(*dns.SRV).Target != "web.node1.protos.internal." {
+			t.Errorf("expected SRV record for port 53, got %v", resp)
+		}
+	})
+
+	t.Run("TXT", func(t *testing.T) {
+		resp := query(h, "web.node1.protos.internal.", dns.TypeTXT)
+		if len(resp.Answer) != 1 || resp.Answer[0].(*dns.TXT).Txt[1] != "instance=node1" {
+			t.Errorf("expected TXT record, got %v", resp)
+		}
+	})
+
+	t.Run("PTR", func(t *testing.T) {
+		resp := query(h, "5.1.100.10.in-addr.arpa.", dns.TypePTR)
+		if len(resp.Answer) != 1 || resp.Answer[0].(*dns.PTR).Ptr != "web.node1.protos.internal." {
+			t.Errorf("expected PTR record, got %v", resp)
+		}
+	})
+
+	t.Run("SOA and NS", func(t *testing.T) {
+		resp := query(h, "protos.internal.", dns.TypeSOA)
+		if len(resp.Answer) != 1 || !resp.Authoritative {
+			t.Errorf("expected SOA record, got %v", resp)
+		}
+		resp = query(h, "protos.internal.", dns.TypeNS)
+		if len(resp.Answer) != 1 || len(resp.Extra) != 1 {
+			t.Errorf("expected NS record with glue, got %v", resp)
+		}
+	})
+
+	t.Run("NXDOMAIN", func(t *testing.T) {
+		for _, name := range []string{"missing.protos.internal.", "a.b.web.protos.internal.", "web.node3.protos.internal.", "6.1.100.10.in-addr.arpa."} {
+			resp := query(h, name, dns.TypeA)
+			if resp.Rcode != dns.RcodeNameError || len(resp.Ns) != 1 {
+				t.Errorf("expected NXDOMAIN for '%s', got %v", name, resp)
+			}
+		}
+	})
+
+	t.Run("User records", func(t *testing.T) {
+		resp := query(h, "printer.protos.internal.", dns.TypeA)
+		if len(resp.Answer) != 1 || !resp.Answer[0].(*dns.A).A.Equal(net.ParseIP("192.168.1.10")) {
+			t.Errorf("expected static A record, got %v", resp)
+		}
+		resp = query(h, "www.protos.internal.", dns.TypeA)
+		if len(resp.Answer) != 2 || resp.Answer[0].(*dns.CNAME).Target != "web.node1.protos.internal." || !resp.Answer[1].(*dns.A).A.Equal(apps[0].IP) {
+			t.Errorf("expected CNAME followed by the app A record, got %v", resp)
+		}
+		resp = query(h, "a.b.lab.protos.internal.", dns.TypeA)
+		if len(resp.Answer) != 1 || resp.Answer[0].Header().Name != "a.b.lab.protos.internal." {
+			t.Errorf("expected wildcard A record, got %v", resp)
+		}
+		resp = query(h, "lab.protos.internal.", dns.TypeA)
+		if resp.Rcode != dns.RcodeNameError {
+			t.Errorf("expected wildcard not to match its parent, got %v", resp)
+		}
+	})
+
+	t.Run("Refused", func(t *testing.T) {
+		resp := query(h, "example.com.", dns.TypeA)
+		if resp.Rcode != dns.RcodeRefused {
+			t.Errorf("expected REFUSED, got %v", resp)
+		}
+	})
+}
+
+func TestCache(t *testing.T) {
+	c := &cache{entries: map[string]cacheEntry{}}
+	now := time.Now()
+
+	req := &dns.Msg{}
+	req.SetQuestion("example.com.", dns.TypeA)
+	resp := &dns.Msg{}
+	resp.SetReply(req)
+	resp.Answer = []dns.RR{&dns.A{Hdr: dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 30}, A: net.ParseIP("1.2.3.4")}}
+	c.set(req.Question[0], resp, now)
+
+	cached := c.get(req.Question[0], now.Add(10*time.Second))
+	if cached == nil || cached.Answer[0].Header().Ttl != 20 {
+		t.Errorf("expected cached response with a TTL of 20, got %v", cached)
+	}
+	if c.get(req.Question[0], now.Add(30*time.Second)) != nil {
+		t.Error("expected response to expire after its TTL")
+	}
+
+	resp.Answer = nil
+	resp.Rcode = dns.RcodeServerFailure
+	c.set(req.Question[0], resp, now)
+	if c.get(req.Question[0], now) != nil {
+		t.Error("expected failed responses not to be cached")
+	}
+}
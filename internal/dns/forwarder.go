@@ -0,0 +1,332 @@
+package dns
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	tlsPrefix        = "tls://"
+	upstreamTimeout  = 2 * time.Second
+	upstreamMinDelay = 5 * time.Second
+	upstreamMaxDelay = time.Minute
+	cacheMaxEntries  = 10000
+	cacheMaxTTL      = time.Hour
+	udpBufferSize    = 4096
+)
+
+var (
+	cacheHits = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "protos",
+		Subsystem: "dns",
+		Name:      "cache_hits_total",
+		Help:      "Number of forwarded DNS queries answered from the cache",
+	})
+	cacheMisses = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "protos",
+		Subsystem: "dns",
+		Name:      "cache_misses_total",
+		Help:      "Number of forwarded DNS queries that were sent to an upstream server",
+	})
+	upstreamErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "protos",
+		Subsystem: "dns",
+		Name:      "upstream_errors_total",
+		Help:      "Number of failed queries per upstream DNS server",
+	}, []string{"upstream"})
+)
+
+//
+// Upstream servers
+//
+
+// upstream is a DNS server that external queries are forwarded to. Upstreams that fail are skipped for an
+// exponentially increasing period of time
+type upstream struct {
+	name       string
+	addr       string
+	net        string
+	serverName string
+	failures   int
+	downUntil  time.Time
+}
+
+// parseUpstream parses an upstream in the [tls://]<ip>:<port>[#<tls server name>] format. The tls:// prefix enables
+// DNS-over-TLS, in which case the port defaults to 853 and the certificate is checked against the server name
+func parseUpstream(server string) (*upstream, error) {
+	up := &upstream{name: server, net: "udp"}
+	addr := server
+	if strings.HasPrefix(addr, tlsPrefix) {
+		up.net = "tcp-tls"
+		addr = strings.TrimPrefix(addr, tlsPrefix)
+		if i := strings.Index(addr, "#"); i >= 0 {
+			up.serverName = addr[i+1:]
+			addr = addr[:i]
+		}
+	}
+
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		// the port is optional
+		host = addr
+		port = "53"
+		if up.net == "tcp-tls" {
+			port = "853"
+		}
+	}
+	if net.ParseIP(host) == nil {
+		return nil, fmt.Errorf("invalid upstream DNS server '%s': '%s' is not an IP address", server, host)
+	}
+	if up.serverName == "" {
+		up.serverName = host
+	}
+	up.addr = net.JoinHostPort(host, port)
+	return up, nil
+}
+
+func (up *upstream) client(network string) *dns.Client {
+	c := &dns.Client{Net: network, Timeout: upstreamTimeout, UDPSize: udpBufferSize}
+	if network == "tcp-tls" {
+		c.TLSConfig = &tls.Config{ServerName: up.serverName}
+	}
+	return c
+}
+
+// exchange sends a query to the upstream. Truncated UDP responses are retried over TCP
+func (up *upstream) exchange(r *dns.Msg) (*dns.Msg, error) {
+	resp, _, err := up.client(up.net).Exchange(r, up.addr)
+	if err == nil && resp.Truncated && up.net == "udp" {
+		resp, _, err = up.client("tcp").Exchange(r, up.addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+//
+// Cache
+//
+
+type cacheEntry struct {
+	msg     *dns.Msg
+	stored  time.Time
+	expires time.Time
+}
+
+// cache stores upstream responses for as long as their TTL allows
+type cache struct {
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+func cacheKey(q dns.Question) string {
+	return fmt.Sprintf("%s/%d/%d", strings.ToLower(q.Name), q.Qtype, q.Qclass)
+}
+
+// responseTTL returns how long a response can be cached. Negative responses are cached according to the SOA record
+// in the authority section, as described in RFC 2308
+func responseTTL(msg *dns.Msg) time.Duration {
+	if msg.Truncated || (msg.Rcode != dns.RcodeSuccess && msg.Rcode != dns.RcodeNameError) {
+		return 0
+	}
+
+	var ttl uint32
+	found := false
+	minTTL := func(t uint32) {
+		if !found || t < ttl {
+			ttl = t
+			found = true
+		}
+	}
+
+	if len(msg.Answer) == 0 {
+		for _, rr := range msg.Ns {
+			if soa, ok := rr.(*dns.SOA); ok {
+				minTTL(soa.Hdr.Ttl)
+				minTTL(soa.Minttl)
+			}
+		}
+	} else {
+		for _, rrs := range [][]dns.RR{msg.Answer, msg.Ns, msg.Extra} {
+			for _, rr := range rrs {
+				if rr.Header().Rrtype != dns.TypeOPT {
+					minTTL(rr.Header().Ttl)
+				}
+			}
+		}
+	}
+
+	duration := time.Duration(ttl) * time.Second
+	if duration > cacheMaxTTL {
+		duration = cacheMaxTTL
+	}
+	return duration
+}
+
+func (c *cache) get(q dns.Question, now time.Time) *dns.Msg {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, found := c.entries[cacheKey(q)]
+	if !found {
+		return nil
+	}
+	if !now.Before(entry.expires) {
+		delete(c.entries, cacheKey(q))
+		return nil
+	}
+
+	// the TTLs of the returned records are reduced by the time spent in the cache
+	age := uint32(now.Sub(entry.stored) / time.Second)
+	msg := entry.msg.Copy()
+	for _, rrs := range [][]dns.RR{msg.Answer, msg.Ns, msg.Extra} {
+		for _, rr := range rrs {
+			if rr.Header().Rrtype == dns.TypeOPT {
+				continue
+			}
+			if rr.Header().Ttl > age {
+				rr.Header().Ttl -= age
+			} else {
+				rr.Header().Ttl = 0
+			}
+		}
+	}
+	return msg
+}
+
+func (c *cache) set(q dns.Question, msg *dns.Msg, now time.Time) {
+	ttl := responseTTL(msg)
+	if ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.entries) >= cacheMaxEntries {
+		for key, entry := range c.entries {
+			if !now.Before(entry.expires) {
+				delete(c.entries, key)
+			}
+		}
+	}
+	if len(c.entries) >= cacheMaxEntries {
+		// no expired entries, so a random one is evicted
+		for key := range c.entries {
+			delete(c.entries, key)
+			break
+		}
+	}
+
+	c.entries[cacheKey(q)] = cacheEntry{msg: msg.Copy(), stored: now, expires: now.Add(ttl)}
+}
+
+//
+// Forwarder
+//
+
+// forwarder resolves external queries using a list of upstream servers, in order of preference. Responses are cached
+// according to their TTL
+type forwarder struct {
+	mu        sync.Mutex
+	upstreams []*upstream
+	cache     *cache
+}
+
+// candidates returns the upstreams that should be tried, with the healthy ones first. The upstreams that are
+// considered down are still tried as a last resort
+func (f *forwarder) candidates(now time.Time) []*upstream {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	healthy := []*upstream{}
+	down := []*upstream{}
+	for _, up := range f.upstreams {
+		if now.Before(up.downUntil) {
+			down = append(down, up)
+		} else {
+			healthy = append(healthy, up)
+		}
+	}
+	return append(healthy, down...)
+}
+
+func (f *forwarder) markFailed(up *upstream, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	upstreamErrors.WithLabelValues(up.name).Inc()
+	delay := upstreamMinDelay << up.failures
+	if delay > upstreamMaxDelay || delay <= 0 {
+		delay = upstreamMaxDelay
+	} else {
+		up.failures++
+	}
+	up.downUntil = time.Now().Add(delay)
+	log.Warnf("Upstream DNS server '%s' failed, skipping it for %s: %s", up.name, delay.String(), err.Error())
+}
+
+func (f *forwarder) markHealthy(up *upstream) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if up.failures > 0 {
+		log.Infof("Upstream DNS server '%s' is healthy again", up.name)
+	}
+	up.failures = 0
+	up.downUntil = time.Time{}
+}
+
+// Forward answers a query from the cache, or sends it to the upstream servers until one of them responds
+func (f *forwarder) Forward(r *dns.Msg) (*dns.Msg, error) {
+	question := r.Question[0]
+	if msg := f.cache.get(question, time.Now()); msg != nil {
+		cacheHits.Inc()
+		msg.Id = r.Id
+		return msg, nil
+	}
+	cacheMisses.Inc()
+
+	var lastErr error
+	for _, up := range f.candidates(time.Now()) {
+		resp, err := up.exchange(r)
+		if err == nil && (resp.Rcode == dns.RcodeServerFailure || resp.Rcode == dns.RcodeRefused) {
+			err = fmt.Errorf("server responded with %s", dns.RcodeToString[resp.Rcode])
+		}
+		if err != nil {
+			f.markFailed(up, err)
+			lastErr = err
+			continue
+		}
+		f.markHealthy(up)
+		f.cache.set(question, resp, time.Now())
+		return resp, nil
+	}
+
+	if lastErr == nil {
+		return nil, fmt.Errorf("no upstream DNS servers configured")
+	}
+	return nil, fmt.Errorf("all upstream DNS servers failed. Last error: %w", lastErr)
+}
+
+// newForwarder creates a forwarder for the provided upstream servers
+func newForwarder(servers []string) (*forwarder, error) {
+	f := &forwarder{cache: &cache{entries: map[string]cacheEntry{}}}
+	for _, server := range servers {
+		up, err := parseUpstream(server)
+		if err != nil {
+			return nil, err
+		}
+		f.upstreams = append(f.upstreams, up)
+	}
+	return f, nil
+}
@@ -0,0 +1,227 @@
+package dns
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/bokwoon95/sq"
+	"github.com/miekg/dns"
+
+	"github.com/protosio/protos/internal/db"
+)
+
+// Supported user defined record types
+const (
+	RecordA     = "A"
+	RecordAAAA  = "AAAA"
+	RecordCNAME = "CNAME"
+)
+
+func createRecordInsertMapper(record Record) func() (sq.Table, func(*sq.Column)) {
+	return func() (sq.Table, func(*sq.Column)) {
+		r := sq.New[db.DNS_RECORD]("")
+		return r, func(col *sq.Column) {
+			col.SetString(r.NAME, record.Name)
+			col.SetString(r.TYPE, record.Type)
+			col.SetString(r.VALUE, record.Value)
+		}
+	}
+}
+
+func createRecordQueryMapper(r db.DNS_RECORD, predicates []sq.Predicate) func() (sq.Table, func(row *sq.Row) Record, []sq.Predicate) {
+	return func() (sq.Table, func(row *sq.Row) Record, []sq.Predicate) {
+		mapper := func(row *sq.Row) Record {
+			return Record{
+				Name:  row.StringField(r.NAME),
+				Type:  row.StringField(r.TYPE),
+				Value: row.StringField(r.VALUE),
+			}
+		}
+		return r, mapper, predicates
+	}
+}
+
+func recordPredicates(r db.DNS_RECORD, name string, rrtype string) []sq.Predicate {
+	predicates := []sq.Predicate{r.NAME.EqString(name)}
+	if rrtype != "" {
+		predicates = append(predicates, r.TYPE.EqString(rrtype))
+	}
+	return predicates
+}
+
+func createRecordDeleteQuery(name string, rrtype string) func() (sq.Table, []sq.Predicate) {
+	return func() (sq.Table, []sq.Predicate) {
+		r := sq.New[db.DNS_RECORD]("")
+		return r, recordPredicates(r, name, rrtype)
+	}
+}
+
+// Record is a user defined DNS record in the internal domain. The name is relative to the domain, and can start with
+// a * label, in which case it matches all the names under it that don't have a record of their own. CNAME values
+// without a trailing dot are relative to the internal domain
+type Record struct {
+	Name  string
+	Type  string
+	Value string
+}
+
+// IsWildcard returns true if the record matches multiple names
+func (r Record) IsWildcard() bool {
+	return r.Name == "*" || strings.HasPrefix(r.Name, "*.")
+}
+
+// fqdn returns the fully qualified name of the record
+func (r Record) fqdn(zone string) string {
+	if r.Name == "@" {
+		return zone
+	}
+	return r.Name + "." + zone
+}
+
+// target returns the fully qualified name a CNAME record points to
+func (r Record) target(zone string) string {
+	if strings.HasSuffix(r.Value, ".") {
+		return strings.ToLower(r.Value)
+	}
+	return strings.ToLower(r.Value) + "." + zone
+}
+
+// matches checks if a wildcard record covers a name
+func (r Record) matches(name string, zone string) bool {
+	if r.Name == "*" {
+		return dns.IsSubDomain(zone, name) && name != zone
+	}
+	parent := strings.TrimPrefix(r.Name, "*.") + "." + zone
+	return dns.IsSubDomain(parent, name) && name != parent
+}
+
+func validateRecord(record Record) (Record, error) {
+	record.Name = strings.ToLower(strings.TrimSuffix(record.Name, "."))
+	record.Type = strings.ToUpper(record.Type)
+
+	if record.Name == "" {
+		return record, fmt.Errorf("record name cannot be empty")
+	}
+	labels := dns.SplitDomainName(record.Name)
+	for i, label := range labels {
+		if label == "*" && i == 0 {
+			continue
+		}
+		if label == "@" && len(labels) == 1 {
+			continue
+		}
+		if _, ok := dns.IsDomainName(label); !ok || strings.ContainsAny(label, "*@") {
+			return record, fmt.Errorf("invalid record name '%s'", record.Name)
+		}
+	}
+
+	switch record.Type {
+	case RecordA:
+		ip := net.ParseIP(record.Value)
+		if ip == nil || ip.To4() == nil {
+			return record, fmt.Errorf("invalid IPv4 address '%s' for A record '%s'", record.Value, record.Name)
+		}
+		record.Value = ip.To4().String()
+	case RecordAAAA:
+		ip := net.ParseIP(record.Value)
+		if ip == nil || ip.To4() != nil {
+			return record, fmt.Errorf("invalid IPv6 address '%s' for AAAA record '%s'", record.Value, record.Name)
+		}
+		record.Value = ip.String()
+	case RecordCNAME:
+		if _, ok := dns.IsDomainName(record.Value); !ok || record.Value == "" {
+			return record, fmt.Errorf("invalid target '%s' for CNAME record '%s'", record.Value, record.Name)
+		}
+		if record.Name == "@" {
+			return record, fmt.Errorf("CNAME records can't be created for the domain itself")
+		}
+	default:
+		return record, fmt.Errorf("record type '%s' is not supported. Only A, AAAA and CNAME records are supported", record.Type)
+	}
+	return record, nil
+}
+
+// RecordManager stores the user defined DNS records in the db, which makes them available on all instances and clients
+type RecordManager struct {
+	db *db.DB
+}
+
+// CreateRecordManager returns a manager for the user defined DNS records
+func CreateRecordManager(db *db.DB) *RecordManager {
+	return &RecordManager{db: db}
+}
+
+// AddRecord validates and stores a record. A name can have either a CNAME record or address records
+func (rm *RecordManager) AddRecord(name string, rrtype string, value string) (Record, error) {
+	return addRecord(rm.db, name, rrtype, value)
+}
+
+// ImportRecord stores a record restored from an export of the state, as part of the transaction of the import
+func (rm *RecordManager) ImportRecord(q db.Querier, name string, rrtype string, value string) error {
+	_, err := addRecord(q, name, rrtype, value)
+	return err
+}
+
+func addRecord(q db.Querier, name string, rrtype string, value string) (Record, error) {
+	record, err := validateRecord(Record{Name: name, Type: rrtype, Value: value})
+	if err != nil {
+		return record, err
+	}
+
+	records, err := getRecords(q)
+	if err != nil {
+		return record, err
+	}
+	for _, r := range records {
+		if r.Name != record.Name {
+			continue
+		}
+		if r.Type == record.Type && r.Value == record.Value {
+			return record, fmt.Errorf("record '%s' (%s) with value '%s' already exists", record.Name, record.Type, record.Value)
+		}
+		if r.Type == RecordCNAME || record.Type == RecordCNAME {
+			return record, fmt.Errorf("name '%s' already has a %s record, and CNAME records can't be combined with other records", record.Name, r.Type)
+		}
+	}
+
+	err = db.Insert(q, createRecordInsertMapper(record))
+	if err != nil {
+		return record, fmt.Errorf("failed to add DNS record '%s': %w", record.Name, err)
+	}
+	return record, nil
+}
+
+// GetRecords returns all the user defined records
+func (rm *RecordManager) GetRecords() ([]Record, error) {
+	return getRecords(rm.db)
+}
+
+func getRecords(q db.Querier) ([]Record, error) {
+	records, err := db.SelectMultiple(q, createRecordQueryMapper(sq.New[db.DNS_RECORD](""), nil))
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve DNS records: %w", err)
+	}
+	return records, nil
+}
+
+// DeleteRecord deletes the records for a name. If a type is provided, only the records of that type are deleted
+func (rm *RecordManager) DeleteRecord(name string, rrtype string) error {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	rrtype = strings.ToUpper(rrtype)
+
+	r := sq.New[db.DNS_RECORD]("")
+	records, err := db.SelectMultiple(rm.db, createRecordQueryMapper(r, recordPredicates(r, name, rrtype)))
+	if err != nil {
+		return fmt.Errorf("failed to delete DNS record '%s': %w", name, err)
+	}
+	if len(records) == 0 {
+		return fmt.Errorf("could not find DNS record '%s'", name)
+	}
+
+	err = db.Delete(rm.db, createRecordDeleteQuery(name, rrtype))
+	if err != nil {
+		return fmt.Errorf("failed to delete DNS record '%s': %w", name, err)
+	}
+	return nil
+}
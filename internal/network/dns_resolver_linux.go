@@ -1,21 +1,282 @@
 package network
 
 import (
+	"bufio"
+	"bytes"
+	"fmt"
 	"net"
+	"os"
+	"strings"
+	"syscall"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	resolvedService   = "org.freedesktop.resolve1"
+	resolvedObject    = "/org/freedesktop/resolve1"
+	resolvedInterface = "org.freedesktop.resolve1.Manager"
+	resolvConfPath    = "/etc/resolv.conf"
+	resolvConfBackup  = "/etc/resolv.conf.protos"
+	resolvConfHeader  = "# Generated by Protos. The original file is saved at " + resolvConfBackup
 )
 
+// resolvedDNSServer is the D-Bus representation of a DNS server with a custom port, as expected by SetLinkDNSEx
+type resolvedDNSServer struct {
+	Family  int32
+	Address []byte
+	Port    uint16
+	Name    string
+}
+
+// resolvedDomain is the D-Bus representation of a link domain. Routing only domains are not used as search domains
+type resolvedDomain struct {
+	Domain      string
+	RoutingOnly bool
+}
+
+// DNSManager configures split DNS for the Protos domains. It uses systemd-resolved when available, and falls back
+// to rewriting resolv.conf otherwise
 type DNSManager struct {
+	link    string
+	conn    *dbus.Conn
+	domains map[string]bool
+}
+
+// UsesResolved returns true if DNS is configured via systemd-resolved. Without it, resolv.conf is used, which only
+// supports servers on port 53 and can't route queries per domain
+func (m *DNSManager) UsesResolved() bool {
+	return m.conn != nil
+}
+
+// Upstream returns the first non-local name server that was configured before Protos took over resolv.conf
+func (m *DNSManager) Upstream() (string, error) {
+	path := resolvConfPath
+	if _, err := os.Stat(resolvConfBackup); err == nil {
+		path = resolvConfBackup
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read '%s': %w", path, err)
+	}
+
+	for _, server := range parseNameServers(data) {
+		ip := net.ParseIP(server)
+		if ip == nil || ip.IsLoopback() {
+			continue
+		}
+		return net.JoinHostPort(ip.String(), "53"), nil
+	}
+	return "", fmt.Errorf("no upstream name server found in '%s'", path)
 }
 
 func (m *DNSManager) AddDomainServer(domain string, server net.IP, port int) error {
+	if domain == "" {
+		return fmt.Errorf("domain cannot be empty")
+	}
+
+	var err error
+	if m.UsesResolved() {
+		err = m.addResolvedDomain(domain, server, port)
+	} else {
+		err = m.addResolvConfDomain(domain, server, port)
+	}
+	if err != nil {
+		return fmt.Errorf("could not add DNS server for domain '%s': %w", domain, err)
+	}
+	m.domains[domain] = true
 	return nil
 }
 
 func (m *DNSManager) DelDomainServer(domain string) error {
+	if domain == "" {
+		return fmt.Errorf("domain cannot be empty")
+	}
+
+	delete(m.domains, domain)
+	var err error
+	if m.UsesResolved() {
+		err = m.delResolvedDomain()
+	} else if len(m.domains) == 0 {
+		err = m.restoreResolvConf()
+	}
+	if err != nil {
+		return fmt.Errorf("could not delete DNS server for domain '%s': %w", domain, err)
+	}
+	return nil
+}
+
+//
+// systemd-resolved
+//
+
+func (m *DNSManager) linkIndex() (int32, error) {
+	iface, err := net.InterfaceByName(m.link)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find interface '%s': %w", m.link, err)
+	}
+	return int32(iface.Index), nil
+}
+
+func (m *DNSManager) addResolvedDomain(domain string, server net.IP, port int) error {
+	ifindex, err := m.linkIndex()
+	if err != nil {
+		return err
+	}
+
+	family := int32(syscall.AF_INET)
+	address := server.To4()
+	if address == nil {
+		family = syscall.AF_INET6
+		address = server.To16()
+	}
+
+	resolved := m.conn.Object(resolvedService, resolvedObject)
+	servers := []resolvedDNSServer{{Family: family, Address: address, Port: uint16(port)}}
+	err = resolved.Call(resolvedInterface+".SetLinkDNSEx", 0, ifindex, servers).Err
+	if err != nil {
+		// older versions of systemd-resolved don't support custom ports
+		if port != 53 {
+			return fmt.Errorf("failed to set DNS server for link '%s': %w", m.link, err)
+		}
+		legacyServers := []struct {
+			Family  int32
+			Address []byte
+		}{{Family: family, Address: address}}
+		err = resolved.Call(resolvedInterface+".SetLinkDNS", 0, ifindex, legacyServers).Err
+		if err != nil {
+			return fmt.Errorf("failed to set DNS server for link '%s': %w", m.link, err)
+		}
+	}
+
+	// the domains are routing only, so that only queries for the Protos domains are sent to the link
+	domains := []resolvedDomain{{Domain: domain, RoutingOnly: true}}
+	for d := range m.domains {
+		if d != domain {
+			domains = append(domains, resolvedDomain{Domain: d, RoutingOnly: true})
+		}
+	}
+	err = resolved.Call(resolvedInterface+".SetLinkDomains", 0, ifindex, domains).Err
+	if err != nil {
+		return fmt.Errorf("failed to set domains for link '%s': %w", m.link, err)
+	}
+
+	err = resolved.Call(resolvedInterface+".SetLinkDefaultRoute", 0, ifindex, false).Err
+	if err != nil {
+		log.Debugf("Failed to disable default DNS route for link '%s': %s", m.link, err.Error())
+	}
+
+	log.Debugf("Configured systemd-resolved to use '%s:%d' for domain '%s'", server.String(), port, domain)
+	return nil
+}
+
+func (m *DNSManager) delResolvedDomain() error {
+	ifindex, err := m.linkIndex()
+	if err != nil {
+		// the link is already gone, and with it the DNS configuration
+		log.Debugf("Skipping DNS cleanup: %s", err.Error())
+		return nil
+	}
+
+	resolved := m.conn.Object(resolvedService, resolvedObject)
+	if len(m.domains) > 0 {
+		domains := []resolvedDomain{}
+		for d := range m.domains {
+			domains = append(domains, resolvedDomain{Domain: d, RoutingOnly: true})
+		}
+		err = resolved.Call(resolvedInterface+".SetLinkDomains", 0, ifindex, domains).Err
+		if err != nil {
+			return fmt.Errorf("failed to set domains for link '%s': %w", m.link, err)
+		}
+		return nil
+	}
+
+	err = resolved.Call(resolvedInterface+".RevertLink", 0, ifindex).Err
+	if err != nil {
+		return fmt.Errorf("failed to revert DNS configuration for link '%s': %w", m.link, err)
+	}
 	return nil
 }
 
-// NewDNS returns a new DNS manager on MacOS
+//
+// resolv.conf
+//
+
+func parseNameServers(data []byte) []string {
+	servers := []string{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "nameserver" {
+			servers = append(servers, fields[1])
+		}
+	}
+	return servers
+}
+
+func (m *DNSManager) addResolvConfDomain(domain string, server net.IP, port int) error {
+	if port != 53 {
+		return fmt.Errorf("resolv.conf does not support DNS servers on port %d", port)
+	}
+
+	// the original file is saved only once, so that it can be restored on cleanup
+	if _, err := os.Stat(resolvConfBackup); os.IsNotExist(err) {
+		original, err := os.ReadFile(resolvConfPath)
+		if err != nil {
+			return fmt.Errorf("failed to read '%s': %w", resolvConfPath, err)
+		}
+		err = os.WriteFile(resolvConfBackup, original, 0644)
+		if err != nil {
+			return fmt.Errorf("failed to save '%s': %w", resolvConfPath, err)
+		}
+	}
+
+	// resolv.conf can't route queries per domain, so all queries are sent to the Protos DNS server, which forwards
+	// the external ones
+	resolvConf := fmt.Sprintf("%s\nnameserver %s\nsearch %s\n", resolvConfHeader, server.String(), domain)
+	err := os.WriteFile(resolvConfPath, []byte(resolvConf), 0644)
+	if err != nil {
+		return fmt.Errorf("failed to write '%s': %w", resolvConfPath, err)
+	}
+
+	log.Debugf("Configured resolv.conf to use '%s' for domain '%s'", server.String(), domain)
+	return nil
+}
+
+func (m *DNSManager) restoreResolvConf() error {
+	original, err := os.ReadFile(resolvConfBackup)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read '%s': %w", resolvConfBackup, err)
+	}
+
+	err = os.WriteFile(resolvConfPath, original, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to restore '%s': %w", resolvConfPath, err)
+	}
+	return os.Remove(resolvConfBackup)
+}
+
+// NewDNSManager returns a new DNS manager on Linux. systemd-resolved is used if it is reachable over D-Bus
 func NewDNSManager() (*DNSManager, error) {
-	return &DNSManager{}, nil
+	m := &DNSManager{link: wireguardNetworkInterface, domains: map[string]bool{}}
+
+	conn, err := dbus.SystemBus()
+	if err != nil {
+		log.Debugf("D-Bus not available, falling back to resolv.conf: %s", err.Error())
+		return m, nil
+	}
+
+	var hasOwner bool
+	err = conn.BusObject().Call("org.freedesktop.DBus.NameHasOwner", 0, resolvedService).Store(&hasOwner)
+	if err != nil || !hasOwner {
+		log.Debugf("systemd-resolved not available, falling back to resolv.conf")
+		return m, nil
+	}
+
+	m.conn = conn
+	return m, nil
 }
@@ -3,13 +3,25 @@ package network
 import (
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/nustiueudinastea/wirebox/linkmgr"
+	"github.com/protosio/protos/internal/cloud"
+	"github.com/protosio/protos/internal/p2p"
+	p2pproto "github.com/protosio/protos/internal/p2p/proto"
 	"github.com/protosio/protos/internal/util"
+	"golang.zx2c4.com/wireguard/wgctrl"
 	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
 )
 
-// var wgPort int = 10999
+// WireguardPort is the port used by the instances for WireGuard connections
+const WireguardPort = 10999
+
+// handshakeTimeout is the age after which the endpoint observed in a WireGuard handshake is no longer trusted. WireGuard
+// renews the session every 2 minutes, so a peer that is still reachable on that endpoint handshakes more often
+const handshakeTimeout = 3 * time.Minute
+
+var wgPort int = WireguardPort
 var log = util.GetLogger("network")
 
 func NewManager() (*Manager, error) {
@@ -43,3 +55,174 @@ func (m *Manager) Init(network net.IPNet, gateway net.IP, privateKey wgtypes.Key
 func (m *Manager) GetInternalIP() net.IP {
 	return m.gateway
 }
+
+// isMeshIP checks if the IP belongs to the mesh network or is assigned to the provided WireGuard interface
+func isMeshIP(wgInterface string, ip net.IP) bool {
+	_, meshNetwork, err := net.ParseCIDR(cloud.MeshNetwork)
+	if err == nil && meshNetwork.Contains(ip) {
+		return true
+	}
+
+	iface, err := net.InterfaceByName(wgInterface)
+	if err != nil {
+		return false
+	}
+	addrs, err := iface.Addrs()
+	if err != nil {
+		return false
+	}
+	for _, addr := range addrs {
+		if ipNet, ok := addr.(*net.IPNet); ok && ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// PeerResolver returns the endpoints that were discovered for the mesh peers, and the keys they currently use
+type PeerResolver interface {
+	GetPeerEndpoint(publicKey string) (p2p.PeerEndpoint, bool)
+	ResolvePublicKey(machineID string, publicKey string) (string, []string)
+}
+
+// wgPeer holds the information required to configure a WireGuard peer
+type wgPeer struct {
+	name       string
+	machineID  string // identifies the peer in key rotations
+	publicKey  string
+	internalIP string
+	endpoint   net.IP
+	networks   []net.IPNet
+	hub        string // public key of the peer that routes the traffic of an external device
+	hubID      string // name of the hub instance
+}
+
+// resolvePeers determines how each peer is reached: via its public IP, via an endpoint discovered by the p2p layer or,
+// when there is no direct path, via a relay peer that routes the traffic on its behalf. External devices are always
+// reached via their hub, unless the hub is the local machine. Peers that rotated their key are configured with their
+// currently active key
+func resolvePeers(peers []wgPeer, endpoints PeerResolver) []wgPeer {
+	if endpoints != nil {
+		for i, peer := range peers {
+			peers[i].publicKey, _ = endpoints.ResolvePublicKey(peer.machineID, peer.publicKey)
+			if peer.hub != "" {
+				peers[i].hub, _ = endpoints.ResolvePublicKey(peer.hubID, peer.hub)
+			}
+		}
+	}
+
+	index := map[string]int{}
+	for i, peer := range peers {
+		index[peer.publicKey] = i
+	}
+
+	relayed := map[string]bool{}
+	for i, peer := range peers {
+		relay := peer.hub
+		if relay == "" {
+			if peer.endpoint != nil || endpoints == nil {
+				continue
+			}
+
+			endpoint, found := endpoints.GetPeerEndpoint(peer.publicKey)
+			if !found {
+				continue
+			}
+
+			if endpoint.IP != nil {
+				peers[i].endpoint = endpoint.IP
+				continue
+			}
+			relay = endpoint.Relay
+		}
+
+		relayIndex, found := index[relay]
+		if !found || relay == peer.publicKey {
+			continue
+		}
+		log.Debugf("Routing traffic for peer '%s' via relay '%s'", peer.name, peers[relayIndex].name)
+		peers[relayIndex].networks = append(peers[relayIndex].networks, peer.networks...)
+		relayed[peer.publicKey] = true
+	}
+
+	resolvedPeers := []wgPeer{}
+	for _, peer := range peers {
+		if relayed[peer.publicKey] {
+			continue
+		}
+		resolvedPeers = append(resolvedPeers, peer)
+	}
+	return resolvedPeers
+}
+
+// getPeerStats retrieves the WireGuard statistics of all the peers configured on an interface, indexed by their
+// WireGuard public key
+func getPeerStats(iface string) (map[string]*p2pproto.WireguardStatus, error) {
+	wgClient, err := wgctrl.New()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create WireGuard client: %w", err)
+	}
+	defer wgClient.Close()
+
+	device, err := wgClient.Device(iface)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve WireGuard interface '%s': %w", iface, err)
+	}
+
+	stats := map[string]*p2pproto.WireguardStatus{}
+	for _, peer := range device.Peers {
+		peerStats := &p2pproto.WireguardStatus{
+			RxBytes: peer.ReceiveBytes,
+			TxBytes: peer.TransmitBytes,
+		}
+		if peer.Endpoint != nil {
+			peerStats.Endpoint = peer.Endpoint.String()
+		}
+		if !peer.LastHandshakeTime.IsZero() {
+			peerStats.LastHandshake = peer.LastHandshakeTime.Unix()
+		}
+		stats[peer.PublicKey.String()] = peerStats
+	}
+
+	return stats, nil
+}
+
+// observedEndpoints retrieves the endpoints that WireGuard learned from the recent handshakes of the peers configured on
+// an interface, indexed by their WireGuard public key
+func observedEndpoints(iface string) (map[string]*net.UDPAddr, error) {
+	wgClient, err := wgctrl.New()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create WireGuard client: %w", err)
+	}
+	defer wgClient.Close()
+
+	device, err := wgClient.Device(iface)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve WireGuard interface '%s': %w", iface, err)
+	}
+
+	return ObservedEndpoints(device), nil
+}
+
+// ObservedEndpoints returns the endpoints that WireGuard learned from the recent handshakes of the peers of a device,
+// indexed by their WireGuard public key. For peers behind NAT, these contain the port mapped by the NAT
+func ObservedEndpoints(device *wgtypes.Device) map[string]*net.UDPAddr {
+	endpoints := map[string]*net.UDPAddr{}
+	for _, peer := range device.Peers {
+		if peer.Endpoint == nil || time.Since(peer.LastHandshakeTime) > handshakeTimeout {
+			continue
+		}
+		endpoints[peer.PublicKey.String()] = peer.Endpoint
+	}
+	return endpoints
+}
+
+// PeerEndpoint returns the UDP endpoint of a peer. The WireGuard port of a peer behind NAT is not known upfront, so the
+// endpoint observed in a recent handshake is kept as long as it matches the peer's IP. Otherwise, the peer is expected
+// to listen on the default WireGuard port
+func PeerEndpoint(ip net.IP, observed *net.UDPAddr) *net.UDPAddr {
+	if observed != nil && observed.IP.Equal(ip) {
+		return observed
+	}
+	return &net.UDPAddr{IP: ip, Port: wgPort}
+}
@@ -4,10 +4,13 @@ import (
 	"fmt"
 	"net"
 	"os/exec"
+	"strings"
 
 	"github.com/protosio/protos/internal/auth"
 	"github.com/protosio/protos/internal/cloud"
+	p2pproto "github.com/protosio/protos/internal/p2p/proto"
 	"github.com/protosio/protos/internal/pcrypto"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
 )
 
 const (
@@ -49,27 +52,98 @@ func (m *Manager) Down() error {
 	return nil
 }
 
-func (m *Manager) ConfigurePeers(instances []cloud.InstanceInfo, devices []auth.UserDevice) error {
+// GetPeerStats returns the WireGuard statistics for all the configured peers, indexed by their WireGuard public key
+func (m *Manager) GetPeerStats() (map[string]*p2pproto.WireguardStatus, error) {
+	return getPeerStats(protosNetworkInterface)
+}
+
+// IsMeshIP checks if the IP is only reachable over WireGuard, in which case it can't be used as a peer endpoint
+func (m *Manager) IsMeshIP(ip net.IP) bool {
+	return isMeshIP(protosNetworkInterface, ip)
+}
+
+// SetPrivateKey replaces the WireGuard private key, which is applied by wg-protos on the next peer configuration
+func (m *Manager) SetPrivateKey(privateKey wgtypes.Key) error {
+	m.privateKey = privateKey
+	return nil
+}
+
+// ConfigurePeers configures the WireGuard peers using wg-protos. Peers without a public IP use the endpoints
+// discovered by the resolver, or are routed via a relay peer
+func (m *Manager) ConfigurePeers(instances []cloud.InstanceInfo, devices []auth.UserDevice, endpoints PeerResolver) error {
 
 	log.Debug("Configuring network peers")
-	peerConfigs := []string{}
+	wgPeers := []wgPeer{}
 
 	for _, instance := range instances {
-		if len(instance.PublicKey) == 0 || instance.PublicIP == "" || instance.InternalIP == "" || instance.Network == "" || instance.Name == "" {
+		if len(instance.PublicKey) == 0 || instance.InternalIP == "" || instance.Network == "" || instance.Name == "" {
 			continue
 		}
 
-		pubkey, err := pcrypto.ConvertPublicEd25519ToCurve25519(instance.PublicKey)
+		_, instanceNetwork, err := net.ParseCIDR(instance.Network)
 		if err != nil {
-			return fmt.Errorf("failed to configure network (%s): %w", instance.Name, err)
+			return fmt.Errorf("failed to parse network for instance '%s': %w", instance.Name, err)
 		}
 
-		_, instanceNetwork, err := net.ParseCIDR(instance.Network)
+		wgPeers = append(wgPeers, wgPeer{
+			name:       instance.Name,
+			machineID:  instance.GetMachineID(),
+			publicKey:  instance.GetPublicKey(),
+			internalIP: instance.InternalIP,
+			endpoint:   net.ParseIP(instance.PublicIP),
+			networks:   []net.IPNet{*instanceNetwork},
+		})
+	}
+
+	instanceKeys := map[string]string{}
+	for _, instance := range instances {
+		instanceKeys[instance.Name] = instance.GetPublicKey()
+	}
+
+	// external devices are reached via their hub instance
+	for _, userDevice := range devices {
+		if !userDevice.IsExternal() {
+			continue
+		}
+
+		_, deviceNetwork, err := net.ParseCIDR(userDevice.Network)
 		if err != nil {
-			return fmt.Errorf("failed to parse network for instance '%s': %w", instance.Name, err)
+			return fmt.Errorf("failed to parse network for device '%s': %w", userDevice.Name, err)
+		}
+
+		wgPeers = append(wgPeers, wgPeer{
+			name:      userDevice.Name,
+			machineID: userDevice.GetMachineID(),
+			publicKey: userDevice.GetPublicKey(),
+			networks:  []net.IPNet{*deviceNetwork},
+			hub:       instanceKeys[userDevice.Hub],
+			hubID:     userDevice.Hub,
+		})
+	}
+
+	peerConfigs := []string{}
+	for _, wgp := range resolvePeers(wgPeers, endpoints) {
+		if wgp.hub != "" {
+			log.Warnf("Skipping external device '%s' because its hub is not available", wgp.name)
+			continue
+		}
+
+		pubkey, err := pcrypto.ConvertPublicEd25519ToCurve25519(wgp.publicKey)
+		if err != nil {
+			return fmt.Errorf("failed to configure network (%s): %w", wgp.name, err)
+		}
+
+		endpoint := ""
+		if wgp.endpoint != nil {
+			endpoint = wgp.endpoint.String()
+		}
+
+		networks := []string{}
+		for _, network := range wgp.networks {
+			networks = append(networks, network.String())
 		}
 
-		peerConf := fmt.Sprintf("%s:%s:%s:%s:%s", instance.Name, pubkey.String(), instance.PublicIP, instance.InternalIP, instanceNetwork.String())
+		peerConf := fmt.Sprintf("%s:%s:%s:%s:%s", wgp.name, pubkey.String(), endpoint, wgp.internalIP, strings.Join(networks, ","))
 		peerConfigs = append(peerConfigs, peerConf)
 	}
 
@@ -15,6 +15,7 @@ import (
 	"github.com/nustiueudinastea/wirebox/linkmgr"
 	"github.com/protosio/protos/internal/auth"
 	"github.com/protosio/protos/internal/cloud"
+	p2pproto "github.com/protosio/protos/internal/p2p/proto"
 	"github.com/protosio/protos/internal/pcrypto"
 	"github.com/vishvananda/netlink"
 	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
@@ -26,7 +27,6 @@ const (
 	wgProtosBinary            = "wg-protos"
 )
 
-var wgPort int = 10999
 var netBridge *netlink.Bridge
 
 func compareRoutes(a netlink.Route, b netlink.Route) bool {
@@ -201,7 +201,34 @@ func (m *Manager) Down() error {
 	return nil
 }
 
-func (m *Manager) ConfigurePeers(instances []cloud.InstanceInfo, devices []auth.UserDevice) error {
+// GetPeerStats returns the WireGuard statistics for all the configured peers, indexed by their WireGuard public key
+func (m *Manager) GetPeerStats() (map[string]*p2pproto.WireguardStatus, error) {
+	return getPeerStats(wireguardNetworkInterface)
+}
+
+// IsMeshIP checks if the IP is only reachable over WireGuard, in which case it can't be used as a peer endpoint
+func (m *Manager) IsMeshIP(ip net.IP) bool {
+	return isMeshIP(wireguardNetworkInterface, ip)
+}
+
+// SetPrivateKey replaces the WireGuard private key of the interface, and is used when the local key is rotated
+func (m *Manager) SetPrivateKey(privateKey wgtypes.Key) error {
+	m.privateKey = privateKey
+	lnk, err := m.linkManager.GetLink(wireguardNetworkInterface)
+	if err != nil {
+		return fmt.Errorf("failed to configure interface '%s': %w", wireguardNetworkInterface, err)
+	}
+
+	err = lnk.ConfigureWG(wgtypes.Config{PrivateKey: &m.privateKey})
+	if err != nil {
+		return fmt.Errorf("failed to set private key on interface '%s': %w", wireguardNetworkInterface, err)
+	}
+	return nil
+}
+
+// ConfigurePeers configures the WireGuard peers and their routes. Peers without a public IP use the endpoints
+// discovered by the resolver, or are routed via a relay peer
+func (m *Manager) ConfigurePeers(instances []cloud.InstanceInfo, devices []auth.UserDevice, endpoints PeerResolver) error {
 
 	if m.gateway == nil || m.domain == "" || m.network.String() == "<nil>" {
 		log.Debugf("Skipping peer configuration because the network is not configured yet")
@@ -216,7 +243,7 @@ func (m *Manager) ConfigurePeers(instances []cloud.InstanceInfo, devices []auth.
 
 	// create the peer and routes lists. At the moment these are all the devices that a user has
 	newRoutes := []netlink.Route{}
-	peers := []wgtypes.PeerConfig{}
+	wgPeers := []wgPeer{}
 	if len(devices) == 0 {
 		return fmt.Errorf("failed to configure interface because 0 user devices were provided")
 	}
@@ -227,44 +254,69 @@ func (m *Manager) ConfigurePeers(instances []cloud.InstanceInfo, devices []auth.
 			continue
 		}
 
-		publicKey, err := pcrypto.ConvertPublicEd25519ToCurve25519(instance.PublicKey)
-		if err != nil {
-			return fmt.Errorf("failed to configure network (%s): %w", instance.Name, err)
-		}
-
 		_, instanceNetwork, err := net.ParseCIDR(instance.Network)
 		if err != nil {
 			return fmt.Errorf("failed to parse network for instance '%s': %w", instance.Name, err)
 		}
 
-		newRoutes = append(newRoutes, netlink.Route{Dst: instanceNetwork, Src: m.gateway})
-		peerConf := wgtypes.PeerConfig{
-			PublicKey:         publicKey,
-			ReplaceAllowedIPs: true,
-			Endpoint:          &net.UDPAddr{IP: net.ParseIP(instance.PublicIP), Port: 10999},
-			AllowedIPs:        []net.IPNet{*instanceNetwork},
-		}
+		wgPeers = append(wgPeers, wgPeer{
+			name:      instance.Name,
+			machineID: instance.GetMachineID(),
+			publicKey: instance.GetPublicKey(),
+			endpoint:  net.ParseIP(instance.PublicIP),
+			networks:  []net.IPNet{*instanceNetwork},
+		})
+	}
 
-		peers = append(peers, peerConf)
+	instanceKeys := map[string]string{}
+	for _, instance := range instances {
+		instanceKeys[instance.Name] = instance.GetPublicKey()
 	}
 
 	// build devices peer list
 	for _, userDevice := range devices {
 		log.Debugf("Using route '%s' for device '%s'(%s)", userDevice.Network, userDevice.Name, userDevice.GetPublicKey())
-		publicKeyWG, err := pcrypto.ConvertPublicEd25519ToCurve25519(userDevice.PublicKey)
-		if err != nil {
-			return fmt.Errorf("failed to decode base64 encoded key for device '%s': %w", userDevice.Name, err)
-		}
 		_, deviceNetwork, err := net.ParseCIDR(userDevice.Network)
 		if err != nil {
 			return fmt.Errorf("failed to parse network for device '%s': %w", userDevice.Name, err)
 		}
-		newRoutes = append(newRoutes, netlink.Route{Dst: deviceNetwork, Src: m.gateway})
+
+		wgPeers = append(wgPeers, wgPeer{
+			name:      userDevice.Name,
+			machineID: userDevice.GetMachineID(),
+			publicKey: userDevice.GetPublicKey(),
+			networks:  []net.IPNet{*deviceNetwork},
+			hub:       instanceKeys[userDevice.Hub],
+			hubID:     userDevice.Hub,
+		})
+	}
+
+	// the peers that connected from behind a NAT are only reachable on the endpoint observed in their handshakes
+	observed, err := observedEndpoints(wireguardNetworkInterface)
+	if err != nil {
+		log.Warnf("Failed to retrieve the observed WireGuard endpoints: %s", err.Error())
+	}
+
+	keepAliveInterval := 25 * time.Second
+	peers := []wgtypes.PeerConfig{}
+	for _, wgp := range resolvePeers(wgPeers, endpoints) {
+		publicKey, err := pcrypto.ConvertPublicEd25519ToCurve25519(wgp.publicKey)
+		if err != nil {
+			return fmt.Errorf("failed to configure network (%s): %w", wgp.name, err)
+		}
 
 		peerConf := wgtypes.PeerConfig{
-			PublicKey:         publicKeyWG,
+			PublicKey:         publicKey,
 			ReplaceAllowedIPs: true,
-			AllowedIPs:        []net.IPNet{*deviceNetwork},
+			AllowedIPs:        wgp.networks,
+		}
+		if wgp.endpoint != nil {
+			peerConf.Endpoint = PeerEndpoint(wgp.endpoint, observed[publicKey.String()])
+			peerConf.PersistentKeepaliveInterval = &keepAliveInterval
+		}
+
+		for i := range wgp.networks {
+			newRoutes = append(newRoutes, netlink.Route{Dst: &wgp.networks[i], Src: m.gateway})
 		}
 		peers = append(peers, peerConf)
 	}
@@ -0,0 +1,110 @@
+package network
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/protosio/protos/internal/p2p"
+)
+
+type fakeResolver map[string]p2p.PeerEndpoint
+
+func (fr fakeResolver) GetPeerEndpoint(publicKey string) (p2p.PeerEndpoint, bool) {
+	endpoint, found := fr[publicKey]
+	return endpoint, found
+}
+
+func (fr fakeResolver) ResolvePublicKey(machineID string, publicKey string) (string, []string) {
+	return publicKey, nil
+}
+
+// resolvedPeer is the outcome of resolving a peer: its endpoint and the networks routed to it
+type resolvedPeer struct {
+	endpoint string
+	networks []string
+}
+
+func testPeer(name string, endpoint string, network string) wgPeer {
+	_, ipNet, _ := net.ParseCIDR(network)
+	return wgPeer{name: name, publicKey: name + "-key", endpoint: net.ParseIP(endpoint), networks: []net.IPNet{*ipNet}}
+}
+
+func TestResolvePeers(t *testing.T) {
+	device := testPeer("device", "", "10.100.1.0/24")
+	device.hub = "relay-key"
+
+	tests := []struct {
+		name     string
+		peers    []wgPeer
+		resolver PeerResolver
+		resolved map[string]resolvedPeer
+	}{
+		{
+			name:     "direct peer keeps its public IP",
+			peers:    []wgPeer{testPeer("direct", "1.2.3.4", "10.100.2.0/24")},
+			resolver: fakeResolver{"direct-key": {IP: net.ParseIP("5.6.7.8")}},
+			resolved: map[string]resolvedPeer{"direct": {endpoint: "1.2.3.4", networks: []string{"10.100.2.0/24"}}},
+		},
+		{
+			name:     "peer without public IP uses the discovered endpoint",
+			peers:    []wgPeer{testPeer("natted", "", "10.100.2.0/24")},
+			resolver: fakeResolver{"natted-key": {IP: net.ParseIP("5.6.7.8")}},
+			resolved: map[string]resolvedPeer{"natted": {endpoint: "5.6.7.8", networks: []string{"10.100.2.0/24"}}},
+		},
+		{
+			name:     "relayed peer is routed via the relay",
+			peers:    []wgPeer{testPeer("relay", "1.2.3.4", "10.100.2.0/24"), testPeer("relayed", "", "10.100.3.0/24")},
+			resolver: fakeResolver{"relayed-key": {Relay: "relay-key"}},
+			resolved: map[string]resolvedPeer{"relay": {endpoint: "1.2.3.4", networks: []string{"10.100.2.0/24", "10.100.3.0/24"}}},
+		},
+		{
+			name:     "peer with a missing relay is kept without endpoint",
+			peers:    []wgPeer{testPeer("relay", "1.2.3.4", "10.100.2.0/24"), testPeer("relayed", "", "10.100.3.0/24")},
+			resolver: fakeResolver{"relayed-key": {Relay: "unknown-key"}},
+			resolved: map[string]resolvedPeer{
+				"relay":   {endpoint: "1.2.3.4", networks: []string{"10.100.2.0/24"}},
+				"relayed": {networks: []string{"10.100.3.0/24"}},
+			},
+		},
+		{
+			name:     "peer without a discovered endpoint is kept without endpoint",
+			peers:    []wgPeer{testPeer("unknown", "", "10.100.2.0/24")},
+			resolver: fakeResolver{},
+			resolved: map[string]resolvedPeer{"unknown": {networks: []string{"10.100.2.0/24"}}},
+		},
+		{
+			name:     "external device is routed via its hub",
+			peers:    []wgPeer{testPeer("relay", "1.2.3.4", "10.100.2.0/24"), device},
+			resolver: fakeResolver{},
+			resolved: map[string]resolvedPeer{"relay": {endpoint: "1.2.3.4", networks: []string{"10.100.2.0/24", "10.100.1.0/24"}}},
+		},
+		{
+			name:  "peers are kept as they are without a resolver",
+			peers: []wgPeer{testPeer("direct", "1.2.3.4", "10.100.2.0/24"), testPeer("natted", "", "10.100.3.0/24")},
+			resolved: map[string]resolvedPeer{
+				"direct": {endpoint: "1.2.3.4", networks: []string{"10.100.2.0/24"}},
+				"natted": {networks: []string{"10.100.3.0/24"}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resolved := map[string]resolvedPeer{}
+			for _, peer := range resolvePeers(test.peers, test.resolver) {
+				rp := resolvedPeer{}
+				if peer.endpoint != nil {
+					rp.endpoint = peer.endpoint.String()
+				}
+				for _, network := range peer.networks {
+					rp.networks = append(rp.networks, network.String())
+				}
+				resolved[peer.name] = rp
+			}
+			if !reflect.DeepEqual(resolved, test.resolved) {
+				t.Errorf("expected %v, got %v", test.resolved, resolved)
+			}
+		})
+	}
+}
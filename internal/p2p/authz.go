@@ -0,0 +1,129 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+
+	p2pgrpc "github.com/birros/go-libp2p-grpc"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/protosio/protos/internal/capability"
+	p2pproto "github.com/protosio/protos/internal/p2p/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Capabilities held by the peers, based on the type of their machine
+const (
+	capPeerDevice   = "PeerDevice"
+	capPeerInstance = "PeerInstance"
+	capPeerInit     = "PeerInit"
+)
+
+// methodCaps maps the p2p methods to the capability required to call them. Methods that are not listed here, like
+// the ones used for db synchronisation, require the PeerInstance capability
+var methodCaps = map[string]string{
+	p2pproto.Pinger_Ping_FullMethodName:               capPeerInit,
+	p2pproto.Pinger_Handshake_FullMethodName:          capPeerInit,
+	p2pproto.Instance_Init_FullMethodName:             capPeerInit,
+	p2pproto.Instance_GetPeers_FullMethodName:         capPeerInstance,
+	p2pproto.Instance_GetNetworkStatus_FullMethodName: capPeerInstance,
+	p2pproto.Instance_GetLogs_FullMethodName:          capPeerDevice,
+	p2pproto.Apps_GetAppStatus_FullMethodName:         capPeerInstance,
+	p2pproto.Apps_GetAppLogs_FullMethodName:           capPeerDevice,
+	p2pproto.Tester_ExecSQL_FullMethodName:            capPeerDevice,
+	p2pproto.Tester_GetAllCommits_FullMethodName:      capPeerInstance,
+	p2pproto.Tester_GetHead_FullMethodName:            capPeerInstance,
+}
+
+// setMethodCaps registers the capabilities of the p2p methods with the capability manager
+func setMethodCaps(cm *capability.Manager) {
+	for method, capName := range methodCaps {
+		cm.SetMethodCap(method, cm.GetOrPanic(capName))
+	}
+}
+
+// peerCapability returns the capability of a remote peer. During init, unknown peers are only allowed to initialise
+// the instance
+func (p2p *P2P) peerCapability(peerID peer.ID) (string, bool) {
+	rpcpeer, found := p2p.findPeer(peerID)
+	if found && rpcpeer.GetMachine() != nil {
+		switch rpcpeer.GetMachine().GetType() {
+		case MachineTypeDevice:
+			return capPeerDevice, true
+		case MachineTypeInstance:
+			return capPeerInstance, true
+		}
+	}
+
+	if p2p.initMode.Load() {
+		return capPeerInit, true
+	}
+	return "", false
+}
+
+// authorize checks if the remote peer of a call is allowed to call a method
+func (p2p *P2P) authorize(ctx context.Context, method string) error {
+	peerID, ok := p2pgrpc.RemotePeerFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "no remote peer in context")
+	}
+	return p2p.authorizePeer(peerID, method)
+}
+
+// authorizePeer checks if a remote peer is allowed to call a method
+func (p2p *P2P) authorizePeer(peerID peer.ID, method string) error {
+	peerCap, found := p2p.peerCapability(peerID)
+	if !found {
+		log.Warnf("Rejecting call to '%s' from unknown peer '%s'", method, peerID.String())
+		return status.Errorf(codes.PermissionDenied, "peer '%s' is not known", peerID.String())
+	}
+
+	methodCap, err := p2p.capabilityManager.GetMethodCap(method)
+	if err != nil {
+		methodCap = p2p.capabilityManager.GetOrPanic(capPeerInstance)
+	}
+
+	if !p2p.capabilityManager.Validate(methodCap, peerCap) {
+		log.Warnf("Rejecting call to '%s' from peer '%s' with capability '%s'", method, peerID.String(), peerCap)
+		return status.Errorf(codes.PermissionDenied, "peer '%s' is not allowed to call '%s'", peerID.String(), method)
+	}
+
+	// incompatible peers can only negotiate versions
+	if method != p2pproto.Pinger_Ping_FullMethodName && method != p2pproto.Pinger_Handshake_FullMethodName {
+		if err := p2p.peerIncompatibility(peerID); err != nil {
+			log.Warnf("Rejecting call to '%s' from incompatible peer '%s'", method, peerID.String())
+			return status.Errorf(codes.FailedPrecondition, "peer '%s' is incompatible: %s", peerID.String(), err.Error())
+		}
+	}
+	return nil
+}
+
+// authorizeDeviceStream checks if the remote peer of a stream is a compatible device of the user. The stream
+// protocols used for file transfers and port forwarding are only available to devices
+func (p2p *P2P) authorizeDeviceStream(peerID peer.ID) error {
+	peerCap, found := p2p.peerCapability(peerID)
+	if !found || !p2p.capabilityManager.Validate(p2p.capabilityManager.GetOrPanic(capPeerDevice), peerCap) {
+		return fmt.Errorf("peer '%s' is not a device", peerID.String())
+	}
+	if err := p2p.peerIncompatibility(peerID); err != nil {
+		return fmt.Errorf("peer '%s' is incompatible: %w", peerID.String(), err)
+	}
+	return nil
+}
+
+// unaryAuthzInterceptor enforces the method capabilities for unary calls
+func (p2p *P2P) unaryAuthzInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := p2p.authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// streamAuthzInterceptor enforces the method capabilities for streaming calls
+func (p2p *P2P) streamAuthzInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := p2p.authorize(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
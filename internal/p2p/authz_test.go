@@ -0,0 +1,147 @@
+package p2p
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/protosio/protos/internal/capability"
+	p2pproto "github.com/protosio/protos/internal/p2p/proto"
+	"github.com/protosio/protos/internal/util"
+	"google.golang.org/grpc"
+)
+
+// dbSyncMethod stands for the methods used for db synchronisation, which are not listed in methodCaps
+const dbSyncMethod = "/proto.DBSyncer/GetHead"
+
+type testMachine struct {
+	initMachine
+	machineType string
+}
+
+func (tm *testMachine) GetType() string {
+	return tm.machineType
+}
+
+func generatePeerID(t *testing.T) peer.ID {
+	t.Helper()
+	key, _, err := crypto.GenerateEd25519Key(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	peerID, err := peer.IDFromPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return peerID
+}
+
+// serviceMethods returns the full names of all the methods of the p2p services
+func serviceMethods() []string {
+	methods := []string{dbSyncMethod}
+	for _, desc := range []grpc.ServiceDesc{p2pproto.Pinger_ServiceDesc, p2pproto.Instance_ServiceDesc, p2pproto.Apps_ServiceDesc, p2pproto.Tester_ServiceDesc} {
+		for _, method := range desc.Methods {
+			methods = append(methods, "/"+desc.ServiceName+"/"+method.MethodName)
+		}
+		for _, stream := range desc.Streams {
+			methods = append(methods, "/"+desc.ServiceName+"/"+stream.StreamName)
+		}
+	}
+	return methods
+}
+
+func TestAuthorizePeer(t *testing.T) {
+	p2p := &P2P{
+		peers:             util.NewMap[string, *rpcPeer](),
+		aliases:           util.NewMap[string, string](),
+		capabilityManager: capability.CreateManager(),
+	}
+	setMethodCaps(p2p.capabilityManager)
+
+	deviceID := generatePeerID(t)
+	instanceID := generatePeerID(t)
+	unknownID := generatePeerID(t)
+	p2p.peers.Set(deviceID.String(), &rpcPeer{machine: &testMachine{machineType: MachineTypeDevice}})
+	p2p.peers.Set(instanceID.String(), &rpcPeer{machine: &testMachine{machineType: MachineTypeInstance}})
+
+	deviceOnly := map[string]bool{
+		p2pproto.Tester_ExecSQL_FullMethodName:   true,
+		p2pproto.Instance_GetLogs_FullMethodName: true,
+		p2pproto.Apps_GetAppLogs_FullMethodName:  true,
+	}
+	initMethods := map[string]bool{
+		p2pproto.Pinger_Ping_FullMethodName:      true,
+		p2pproto.Pinger_Handshake_FullMethodName: true,
+		p2pproto.Instance_Init_FullMethodName:    true,
+	}
+
+	t.Run("Device", func(t *testing.T) {
+		for _, method := range serviceMethods() {
+			if err := p2p.authorizePeer(deviceID, method); err != nil {
+				t.Errorf("device should be allowed to call '%s': %s", method, err.Error())
+			}
+		}
+	})
+
+	t.Run("Instance", func(t *testing.T) {
+		for _, method := range serviceMethods() {
+			err := p2p.authorizePeer(instanceID, method)
+			if deviceOnly[method] && err == nil {
+				t.Errorf("instance should not be allowed to call '%s'", method)
+			} else if !deviceOnly[method] && err != nil {
+				t.Errorf("instance should be allowed to call '%s': %s", method, err.Error())
+			}
+		}
+	})
+
+	t.Run("Unknown peer during init", func(t *testing.T) {
+		p2p.initMode.Store(true)
+		defer p2p.initMode.Store(false)
+		for _, method := range serviceMethods() {
+			err := p2p.authorizePeer(unknownID, method)
+			if initMethods[method] && err != nil {
+				t.Errorf("unknown peer should be allowed to call '%s' during init: %s", method, err.Error())
+			} else if !initMethods[method] && err == nil {
+				t.Errorf("unknown peer should not be allowed to call '%s' during init", method)
+			}
+		}
+	})
+
+	t.Run("Unknown peer", func(t *testing.T) {
+		for _, method := range serviceMethods() {
+			if err := p2p.authorizePeer(unknownID, method); err == nil {
+				t.Errorf("unknown peer should not be allowed to call '%s'", method)
+			}
+		}
+	})
+
+	t.Run("Incompatible peer", func(t *testing.T) {
+		incompatibleID := generatePeerID(t)
+		p2p.peers.Set(incompatibleID.String(), &rpcPeer{
+			machine:   &testMachine{machineType: MachineTypeDevice},
+			handshake: &peerHandshake{err: CheckVersionCompatibility("1.0.0", "2.0.0")},
+		})
+		if err := p2p.authorizePeer(incompatibleID, p2pproto.Pinger_Handshake_FullMethodName); err != nil {
+			t.Errorf("incompatible peer should be allowed to negotiate versions: %s", err.Error())
+		}
+		if err := p2p.authorizePeer(incompatibleID, p2pproto.Instance_GetPeers_FullMethodName); err == nil {
+			t.Error("incompatible peer should not be allowed to call other methods")
+		}
+	})
+}
+
+func TestRegisterServices(t *testing.T) {
+	for _, devMode := range []bool{false, true} {
+		p2p := &P2P{grpcServer: grpc.NewServer()}
+		p2p.registerServices(&Server{p2p: p2p}, devMode)
+		services := p2p.grpcServer.GetServiceInfo()
+		for _, desc := range []grpc.ServiceDesc{p2pproto.Pinger_ServiceDesc, p2pproto.Instance_ServiceDesc, p2pproto.Apps_ServiceDesc} {
+			if _, found := services[desc.ServiceName]; !found {
+				t.Errorf("service '%s' should be registered", desc.ServiceName)
+			}
+		}
+		if _, found := services[p2pproto.Tester_ServiceDesc.ServiceName]; found != devMode {
+			t.Errorf("Tester service registered: %t, dev mode: %t", found, devMode)
+		}
+	}
+}
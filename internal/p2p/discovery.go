@@ -0,0 +1,151 @@
+package p2p
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/discovery/mdns"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+)
+
+const (
+	lanDiscoveryService = "_protos._udp"
+	lanAddrTTL          = 10 * time.Minute
+	lanConnectTimeout   = 10 * time.Second
+)
+
+// lanDiscovery finds the peers that are on the same LAN using mDNS. Only the peers that are already known are
+// considered, and their LAN addresses are preferred over the public ones
+type lanDiscovery struct {
+	p2p *P2P
+
+	mu      sync.Mutex
+	service mdns.Service
+	addrs   map[peer.ID]string
+}
+
+// start advertises the provided host on the LAN, and stops the advertisement of a previous host
+func (ld *lanDiscovery) start(h host.Host) {
+	ld.stop()
+
+	service := mdns.NewMdnsService(h, lanDiscoveryService, ld)
+	err := service.Start()
+	if err != nil {
+		log.Warnf("Failed to start LAN discovery: %s", err.Error())
+		return
+	}
+
+	ld.mu.Lock()
+	ld.service = service
+	ld.mu.Unlock()
+}
+
+// stop stops the LAN discovery
+func (ld *lanDiscovery) stop() {
+	ld.mu.Lock()
+	service := ld.service
+	ld.service = nil
+	ld.mu.Unlock()
+
+	if service != nil {
+		if err := service.Close(); err != nil {
+			log.Errorf("Failed to stop LAN discovery: %s", err.Error())
+		}
+	}
+}
+
+// HandlePeerFound is called by the mDNS service for every peer that announces itself on the LAN
+func (ld *lanDiscovery) HandlePeerFound(info peer.AddrInfo) {
+	if !ld.p2p.isKnownPeer(info.ID) {
+		log.Tracef("Ignoring unknown peer '%s' discovered on the LAN", info.ID.String())
+		return
+	}
+
+	lanAddrs := []multiaddr.Multiaddr{}
+	addrsKey := ""
+	for _, addr := range info.Addrs {
+		if manet.IsPrivateAddr(addr) && !manet.IsIPLoopback(addr) && ld.p2p.isEndpointAddr(addr) {
+			lanAddrs = append(lanAddrs, addr)
+			addrsKey += addr.String() + ","
+		}
+	}
+	if len(lanAddrs) == 0 {
+		return
+	}
+
+	h := ld.p2p.getHost()
+	h.Peerstore().AddAddrs(info.ID, lanAddrs, lanAddrTTL)
+
+	// the connection is only switched once for the same set of addresses, so that unreachable LAN addresses don't
+	// cause the peer to reconnect on every announcement
+	ld.mu.Lock()
+	previous := ld.addrs[info.ID]
+	ld.addrs[info.ID] = addrsKey
+	ld.mu.Unlock()
+	if previous == addrsKey {
+		return
+	}
+
+	if _, found := ld.p2p.lanEndpoint(info.ID); found {
+		return
+	}
+	log.Infof("Discovered peer '%s' on the LAN at %v", info.ID.String(), lanAddrs)
+
+	// existing connections use the public address, so they are closed. The peer supervisor then reconnects the
+	// peer, which dials the LAN addresses first
+	if len(h.Network().ConnsToPeer(info.ID)) > 0 {
+		err := h.Network().ClosePeer(info.ID)
+		if err != nil {
+			log.Errorf("Failed to close connection to peer '%s': %s", info.ID.String(), err.Error())
+		}
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), lanConnectTimeout)
+	defer cancel()
+	err := h.Connect(ctx, peer.AddrInfo{ID: info.ID, Addrs: lanAddrs})
+	if err != nil {
+		log.Warnf("Failed to connect to peer '%s' on the LAN: %s", info.ID.String(), err.Error())
+	}
+}
+
+// lanEndpoint returns the LAN endpoint of a peer, if it's connected via a private address
+func (p2p *P2P) lanEndpoint(peerID peer.ID) (PeerEndpoint, bool) {
+	for _, conn := range p2p.getHost().Network().ConnsToPeer(peerID) {
+		remoteAddr := conn.RemoteMultiaddr()
+		if _, err := remoteAddr.ValueForProtocol(multiaddr.P_CIRCUIT); err == nil {
+			continue
+		}
+		if !manet.IsPrivateAddr(remoteAddr) || !p2p.isEndpointAddr(remoteAddr) {
+			continue
+		}
+		ip, err := manet.ToIP(remoteAddr)
+		if err != nil {
+			continue
+		}
+		return PeerEndpoint{IP: ip}, true
+	}
+	return PeerEndpoint{}, false
+}
+
+// isEndpointAddr checks if an address can be used as the WireGuard endpoint of a peer. Addresses of the mesh network
+// and of the local WireGuard interface are only reachable over WireGuard itself, so they are never used
+func (p2p *P2P) isEndpointAddr(addr multiaddr.Multiaddr) bool {
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return false
+	}
+	return p2p.isEndpointIP(ip)
+}
+
+func (p2p *P2P) isEndpointIP(ip net.IP) bool {
+	if p2p.networkManager == nil {
+		return true
+	}
+	return !p2p.networkManager.IsMeshIP(ip)
+}
@@ -0,0 +1,312 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+	p2pproto "github.com/protosio/protos/internal/p2p/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// Event types, used for filtering the events a subscriber receives
+const (
+	EventDBHead         = "db-head"
+	EventAppStatus      = "app-status"
+	EventInstanceStatus = "instance-status"
+	EventPeerJoin       = "peer-join"
+	EventPeerLeave      = "peer-leave"
+)
+
+const (
+	eventBufferSize     = 64
+	maxEventSize        = 64 * 1024
+	eventPublishTimeout = 10 * time.Second
+	headCheckInterval   = 5 * time.Second
+)
+
+// EventType returns the type of an event
+func EventType(event *p2pproto.Event) string {
+	switch event.Payload.(type) {
+	case *p2pproto.Event_DbHead:
+		return EventDBHead
+	case *p2pproto.Event_AppStatus:
+		return EventAppStatus
+	case *p2pproto.Event_InstanceStatus:
+		return EventInstanceStatus
+	case *p2pproto.Event_PeerJoin:
+		return EventPeerJoin
+	case *p2pproto.Event_PeerLeave:
+		return EventPeerLeave
+	default:
+		return "unknown"
+	}
+}
+
+type eventSubscriber struct {
+	events chan *p2pproto.Event
+	types  map[string]bool
+}
+
+// eventBus delivers the events received from the peers, and the local events, to the subscribers
+type eventBus struct {
+	mu          sync.Mutex
+	subscribers map[*eventSubscriber]bool
+}
+
+//
+// Methods for publishing and subscribing to events
+//
+
+// Subscribe returns a channel that receives the events of the provided types, or all events if no type is provided.
+// Events are dropped if the subscriber doesn't keep up. The returned function cancels the subscription
+func (p2p *P2P) Subscribe(types ...string) (<-chan *p2pproto.Event, func()) {
+	sub := &eventSubscriber{events: make(chan *p2pproto.Event, eventBufferSize), types: map[string]bool{}}
+	for _, eventType := range types {
+		sub.types[eventType] = true
+	}
+
+	p2p.events.mu.Lock()
+	p2p.events.subscribers[sub] = true
+	p2p.events.mu.Unlock()
+
+	var once sync.Once
+	return sub.events, func() {
+		once.Do(func() {
+			p2p.events.mu.Lock()
+			delete(p2p.events.subscribers, sub)
+			p2p.events.mu.Unlock()
+			close(sub.events)
+		})
+	}
+}
+
+// dispatch delivers an event to the local subscribers
+func (p2p *P2P) dispatch(event *p2pproto.Event) {
+	eventType := EventType(event)
+
+	p2p.events.mu.Lock()
+	defer p2p.events.mu.Unlock()
+	for sub := range p2p.events.subscribers {
+		if len(sub.types) > 0 && !sub.types[eventType] {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			log.Warnf("Dropping '%s' event for slow subscriber", eventType)
+		}
+	}
+}
+
+// Publish sends an event to the peers over the GossipSub updates topic, and to the local subscribers. The topic mesh
+// forwards the event to the peers that the publisher can't reach directly, like the ones behind a relay
+func (p2p *P2P) Publish(event *p2pproto.Event) error {
+	p2p.hostMu.RLock()
+	h := p2p.host
+	updates := p2p.updates
+	p2p.hostMu.RUnlock()
+
+	event.Timestamp = time.Now().UnixNano()
+	event.Source = h.ID().String()
+
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode '%s' event: %w", EventType(event), err)
+	}
+	p2p.dispatch(event)
+
+	if updates == nil {
+		return fmt.Errorf("failed to publish '%s' event: p2p server not started", EventType(event))
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), eventPublishTimeout)
+	defer cancel()
+	err = updates.topic.Publish(ctx, data)
+	if err != nil {
+		return fmt.Errorf("failed to publish '%s' event: %w", EventType(event), err)
+	}
+	return nil
+}
+
+// PublishAppStatus notifies the peers that the status of an app changed
+func (p2p *P2P) PublishAppStatus(appID string, appName string, instanceName string, status string) error {
+	return p2p.Publish(&p2pproto.Event{Payload: &p2pproto.Event_AppStatus{AppStatus: &p2pproto.AppStatusEvent{
+		AppId:        appID,
+		AppName:      appName,
+		InstanceName: instanceName,
+		Status:       status,
+	}}})
+}
+
+// PublishInstanceStatus notifies the peers that the status of an instance changed
+func (p2p *P2P) PublishInstanceStatus(instanceName string, status string) error {
+	return p2p.Publish(&p2pproto.Event{Payload: &p2pproto.Event_InstanceStatus{InstanceStatus: &p2pproto.InstanceStatusEvent{
+		InstanceName: instanceName,
+		Status:       status,
+	}}})
+}
+
+// peerEvent creates a local event for a peer that connected or disconnected. These events are not published, since
+// each machine has its own view of the connected peers
+func (p2p *P2P) peerEvent(peerID peer.ID, name string, joined bool) {
+	peerEvent := &p2pproto.PeerEvent{PeerId: peerID.String(), Name: name}
+	event := &p2pproto.Event{Timestamp: time.Now().UnixNano(), Source: p2p.getHost().ID().String()}
+	if joined {
+		event.Payload = &p2pproto.Event_PeerJoin{PeerJoin: peerEvent}
+	} else {
+		event.Payload = &p2pproto.Event_PeerLeave{PeerLeave: peerEvent}
+	}
+	p2p.dispatch(event)
+}
+
+//
+// Methods for receiving events from the updates topic
+//
+
+// isKnownPeer checks if a peer is part of the network
+func (p2p *P2P) isKnownPeer(peerID peer.ID) bool {
+	if peerID == p2p.getHost().ID() {
+		return true
+	}
+	if _, found := p2p.peers.Get(peerID.String()); found {
+		return true
+	}
+	_, found := p2p.aliases.Get(peerID.String())
+	return found
+}
+
+// updatesTopic is the subscription of a host to the GossipSub updates topic
+type updatesTopic struct {
+	topic  *pubsub.Topic
+	sub    *pubsub.Subscription
+	cancel context.CancelFunc
+}
+
+// close leaves the updates topic and stops the GossipSub router of the host
+func (ut *updatesTopic) close() {
+	ut.sub.Cancel()
+	err := ut.topic.Close()
+	if err != nil {
+		log.Errorf("Failed to close updates topic: %s", err.Error())
+	}
+	ut.cancel()
+}
+
+// joinUpdates starts a GossipSub router on the provided host, and subscribes to the updates topic. The events received
+// from the peers are delivered to the local subscribers until the topic is closed
+func (p2p *P2P) joinUpdates(h host.Host) (*updatesTopic, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ps, err := pubsub.NewGossipSub(ctx, h,
+		pubsub.WithMessageSignaturePolicy(pubsub.StrictSign),
+		pubsub.WithMaxMessageSize(maxEventSize),
+		pubsub.WithPeerFilter(func(peerID peer.ID, topic string) bool { return p2p.isKnownPeer(peerID) }),
+	)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to start GossipSub router: %w", err)
+	}
+
+	err = ps.RegisterTopicValidator(protosUpdatesTopic, p2p.validateEvent)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to register validator for topic '%s': %w", protosUpdatesTopic, err)
+	}
+	topic, err := ps.Join(protosUpdatesTopic)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to join topic '%s': %w", protosUpdatesTopic, err)
+	}
+	sub, err := topic.Subscribe()
+	if err != nil {
+		topic.Close()
+		cancel()
+		return nil, fmt.Errorf("failed to subscribe to topic '%s': %w", protosUpdatesTopic, err)
+	}
+
+	go p2p.receiveEvents(ctx, h.ID(), sub)
+	return &updatesTopic{topic: topic, sub: sub, cancel: cancel}, nil
+}
+
+// validateEvent accepts the events that are published by a known peer. The publisher is authenticated by the message
+// signature, and has to match the source of the event. Messages are also forwarded to the rest of the mesh only if
+// they pass validation
+func (p2p *P2P) validateEvent(ctx context.Context, from peer.ID, msg *pubsub.Message) bool {
+	publisher := msg.GetFrom()
+	if !p2p.isKnownPeer(publisher) {
+		log.Warnf("Rejecting event published by unknown peer '%s' and received from '%s'", publisher.String(), from.String())
+		return false
+	}
+
+	event := &p2pproto.Event{}
+	err := proto.Unmarshal(msg.Data, event)
+	if err != nil {
+		log.Errorf("Failed to decode event published by '%s': %s", publisher.String(), err.Error())
+		return false
+	}
+	if event.Source != publisher.String() {
+		log.Warnf("Rejecting event with source '%s' published by '%s'", event.Source, publisher.String())
+		return false
+	}
+	msg.ValidatorData = event
+	return true
+}
+
+// receiveEvents delivers the events from the updates topic to the local subscribers. The events published by the host
+// itself are skipped, since they are delivered when they're published
+func (p2p *P2P) receiveEvents(ctx context.Context, self peer.ID, sub *pubsub.Subscription) {
+	for {
+		msg, err := sub.Next(ctx)
+		if err != nil {
+			return
+		}
+		if msg.ReceivedFrom == self {
+			continue
+		}
+		event, ok := msg.ValidatorData.(*p2pproto.Event)
+		if !ok {
+			continue
+		}
+		p2p.dispatch(event)
+	}
+}
+
+// headWatcher publishes an event every time the head of the local db changes, until the context is cancelled
+func (p2p *P2P) headWatcher(ctx context.Context) {
+	if p2p.externalDB == nil {
+		return
+	}
+
+	lastHead := ""
+	ticker := time.NewTicker(headCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		commit, err := p2p.externalDB.GetLastCommit("main")
+		if err != nil {
+			log.Debugf("Failed to retrieve db head: %s", err.Error())
+			continue
+		}
+		if commit.Hash == lastHead {
+			continue
+		}
+
+		// the first head is only recorded, since it doesn't represent a change
+		if lastHead != "" {
+			err = p2p.Publish(&p2pproto.Event{Payload: &p2pproto.Event_DbHead{DbHead: &p2pproto.DBHeadEvent{Head: commit.Hash}}})
+			if err != nil {
+				log.Errorf("Failed to publish db head: %s", err.Error())
+				continue
+			}
+		}
+		lastHead = commit.Hash
+	}
+}
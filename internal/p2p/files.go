@@ -0,0 +1,623 @@
+package p2p
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	p2pproto "github.com/protosio/protos/internal/p2p/proto"
+	"google.golang.org/protobuf/encoding/protodelim"
+)
+
+// File transfer operations
+const (
+	FileOpList = "list"
+	FileOpPull = "pull"
+	FileOpPush = "push"
+)
+
+const (
+	// partial transfers are stored next to the destination file, and are resumed by the next transfer of the file
+	partialFileSuffix  = ".protos-part"
+	maxFileMessageSize = 16 * 1024 * 1024
+)
+
+// TransferStats describes the result of a pull or push
+type TransferStats struct {
+	Files int
+	Bytes int64
+}
+
+//
+// Path and checksum helpers
+//
+
+// confinePath returns the absolute path of a path inside a volume. Symlinks are resolved, so that the path can't
+// point outside the volume
+func confinePath(root string, p string) (string, error) {
+	fullPath := filepath.Join(root, filepath.Clean("/"+p))
+
+	resolvedRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve volume path: %w", err)
+	}
+
+	// the path might not exist yet, so the longest existing prefix is resolved
+	existing := fullPath
+	remaining := ""
+	for {
+		_, err := os.Lstat(existing)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, fs.ErrNotExist) || existing == root {
+			return "", fmt.Errorf("failed to check path '%s': %w", p, err)
+		}
+		remaining = filepath.Join(filepath.Base(existing), remaining)
+		existing = filepath.Dir(existing)
+	}
+	resolved, err := filepath.EvalSymlinks(existing)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve path '%s': %w", p, err)
+	}
+	resolved = filepath.Join(resolved, remaining)
+
+	if resolved != resolvedRoot && !strings.HasPrefix(resolved, resolvedRoot+string(filepath.Separator)) {
+		return "", fmt.Errorf("path '%s' is outside of the app volume", p)
+	}
+	return fullPath, nil
+}
+
+// fileChecksum returns the hex encoded sha256 checksum of a file
+func fileChecksum(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// partialFile is a partial transfer that is resumed by the next transfer of the same file. All the operations are done
+// on the open file, so the partial can't be swapped for a symlink while it's being written
+type partialFile struct {
+	*os.File
+	path string
+}
+
+// openPartial opens or creates a partial transfer, without following symlinks, and returns it together with the
+// number of bytes that can be resumed. A partial that is larger than the maximum size is discarded
+func openPartial(partialPath string, maxSize int64) (*partialFile, int64, error) {
+	if info, err := os.Lstat(partialPath); err == nil && !info.Mode().IsRegular() {
+		if err := os.Remove(partialPath); err != nil {
+			return nil, 0, fmt.Errorf("failed to remove invalid partial transfer: %w", err)
+		}
+	}
+	file, err := os.OpenFile(partialPath, os.O_RDWR|os.O_CREATE|syscall.O_NOFOLLOW, 0600)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to open partial transfer: %w", err)
+	}
+	pf := &partialFile{File: file, path: partialPath}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, 0, fmt.Errorf("failed to check partial transfer: %w", err)
+	}
+	if !info.Mode().IsRegular() {
+		file.Close()
+		return nil, 0, fmt.Errorf("partial transfer '%s' is not a regular file", partialPath)
+	}
+	size := info.Size()
+	if size > maxSize {
+		if err := pf.truncate(0); err != nil {
+			file.Close()
+			return nil, 0, err
+		}
+		size = 0
+	}
+	return pf, size, nil
+}
+
+// truncate discards the partial transfer after an offset
+func (pf *partialFile) truncate(offset int64) error {
+	if err := pf.Truncate(offset); err != nil {
+		return fmt.Errorf("failed to truncate partial transfer: %w", err)
+	}
+	return nil
+}
+
+// appendFrom appends a number of bytes from a reader to the partial transfer
+func (pf *partialFile) appendFrom(reader io.Reader, size int64) error {
+	if _, err := pf.Seek(0, io.SeekEnd); err != nil {
+		return err
+	}
+	_, err := io.CopyN(pf, reader, size)
+	return err
+}
+
+// complete verifies the checksum of the partial transfer and moves it to its destination. The partial is closed
+func (pf *partialFile) complete(destination string, checksum string, mode fs.FileMode) error {
+	defer pf.Close()
+
+	if _, err := pf.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed to verify '%s': %w", destination, err)
+	}
+	hash := sha256.New()
+	if _, err := io.Copy(hash, pf); err != nil {
+		return fmt.Errorf("failed to verify '%s': %w", destination, err)
+	}
+	if hex.EncodeToString(hash.Sum(nil)) != checksum {
+		os.Remove(pf.path)
+		return fmt.Errorf("checksum mismatch for '%s'. The transfer can be retried", destination)
+	}
+	if err := pf.Chmod(mode.Perm()); err != nil {
+		return fmt.Errorf("failed to set permissions for '%s': %w", destination, err)
+	}
+
+	// rename works on paths, so the path has to still point to the file that was verified
+	openInfo, err := pf.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to move '%s' into place: %w", destination, err)
+	}
+	pathInfo, err := os.Lstat(pf.path)
+	if err != nil || !os.SameFile(openInfo, pathInfo) {
+		return fmt.Errorf("failed to move '%s' into place: partial transfer was replaced", destination)
+	}
+	if err := os.Rename(pf.path, destination); err != nil {
+		return fmt.Errorf("failed to move '%s' into place: %w", destination, err)
+	}
+	return nil
+}
+
+func fileInfo(relPath string, info fs.FileInfo) *p2pproto.FileInfo {
+	return &p2pproto.FileInfo{
+		Path:    relPath,
+		Size:    info.Size(),
+		Mode:    uint32(info.Mode().Perm()),
+		ModTime: info.ModTime().Unix(),
+		IsDir:   info.IsDir(),
+	}
+}
+
+//
+// Server side of the file transfer protocol
+//
+
+// serveFiles handles the file transfers requested by the devices of the user
+func (p2p *P2P) serveFiles(h host.Host) {
+	h.SetStreamHandler(protocol.ID(protosFilesProtocol), func(stream network.Stream) {
+		defer stream.Close()
+
+		remotePeer := stream.Conn().RemotePeer()
+		if err := p2p.authorizeDeviceStream(remotePeer); err != nil {
+			log.Warnf("Rejecting file transfer: %s", err.Error())
+			stream.Reset()
+			return
+		}
+
+		reader := bufio.NewReader(stream)
+		req := &p2pproto.FileRequest{}
+		err := protodelim.UnmarshalOptions{MaxSize: maxFileMessageSize}.UnmarshalFrom(reader, req)
+		if err != nil {
+			log.Errorf("Failed to read file request from '%s': %s", remotePeer.String(), err.Error())
+			stream.Reset()
+			return
+		}
+
+		err = p2p.handleFileRequest(req, reader, stream)
+		if err != nil {
+			log.Errorf("Failed to %s '%s' for app '%s': %s", req.Operation, req.Path, req.AppName, err.Error())
+			if _, writeErr := protodelim.MarshalTo(stream, &p2pproto.FileResponse{Error: err.Error()}); writeErr != nil {
+				stream.Reset()
+			}
+		}
+	})
+}
+
+// handleFileRequest performs a file operation inside the volume of a local app
+func (p2p *P2P) handleFileRequest(req *p2pproto.FileRequest, reader *bufio.Reader, stream network.Stream) error {
+	volumePath, err := p2p.appManager.GetVolumePath(req.AppName)
+	if err != nil {
+		return err
+	}
+	fullPath, err := confinePath(volumePath, req.Path)
+	if err != nil {
+		return err
+	}
+	relPath, err := filepath.Rel(volumePath, fullPath)
+	if err != nil {
+		return err
+	}
+
+	switch req.Operation {
+	case FileOpList:
+		return listVolumeFiles(volumePath, fullPath, relPath, stream)
+	case FileOpPull:
+		return sendVolumeFile(fullPath, relPath, req.Offset, stream)
+	case FileOpPush:
+		return receiveVolumeFile(volumePath, fullPath, relPath, req.File, reader, stream)
+	default:
+		return fmt.Errorf("operation '%s' is not supported", req.Operation)
+	}
+}
+
+// listVolumeFiles returns the path and, if it's a directory, everything under it. A missing path results in an
+// empty list
+func listVolumeFiles(volumePath string, fullPath string, relPath string, stream network.Stream) error {
+	resp := &p2pproto.FileResponse{}
+	_, err := os.Stat(fullPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	} else if err == nil {
+		err = filepath.Walk(fullPath, func(filePath string, info fs.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if strings.HasSuffix(filePath, partialFileSuffix) || (!info.IsDir() && !info.Mode().IsRegular()) {
+				return nil
+			}
+			fileRelPath, err := filepath.Rel(volumePath, filePath)
+			if err != nil {
+				return err
+			}
+			resp.Files = append(resp.Files, fileInfo(filepath.ToSlash(fileRelPath), info))
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list '%s': %w", relPath, err)
+		}
+	}
+
+	_, err = protodelim.MarshalTo(stream, resp)
+	return err
+}
+
+// sendVolumeFile sends the contents of a file, starting at an offset
+func sendVolumeFile(fullPath string, relPath string, offset int64, stream network.Stream) error {
+	info, err := os.Stat(fullPath)
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("'%s' is not a regular file", relPath)
+	}
+	// the file changed since the partial download started, so it's sent again from the start
+	if offset < 0 || offset > info.Size() {
+		offset = 0
+	}
+
+	file, err := os.Open(fullPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	checksum, err := fileChecksum(fullPath)
+	if err != nil {
+		return fmt.Errorf("failed to compute checksum for '%s': %w", relPath, err)
+	}
+	respInfo := fileInfo(filepath.ToSlash(relPath), info)
+	respInfo.Sha256 = checksum
+
+	_, err = protodelim.MarshalTo(stream, &p2pproto.FileResponse{Files: []*p2pproto.FileInfo{respInfo}, Offset: offset})
+	if err != nil {
+		return err
+	}
+	_, err = file.Seek(offset, io.SeekStart)
+	if err != nil {
+		return err
+	}
+	_, err = io.CopyN(stream, file, info.Size()-offset)
+	return err
+}
+
+// receiveVolumeFile stores an uploaded file, resuming a previous upload of the same file. The partial upload is
+// confined to the volume like the file itself
+func receiveVolumeFile(volumePath string, fullPath string, relPath string, file *p2pproto.FileInfo, reader *bufio.Reader, stream network.Stream) error {
+	if file == nil || file.Size < 0 || file.Sha256 == "" {
+		return fmt.Errorf("push request is missing the file details")
+	}
+	if info, err := os.Lstat(fullPath); err == nil && info.IsDir() {
+		return fmt.Errorf("'%s' is a directory", file.Path)
+	}
+	err := os.MkdirAll(filepath.Dir(fullPath), 0755)
+	if err != nil {
+		return err
+	}
+
+	partialPath, err := confinePath(volumePath, relPath+partialFileSuffix)
+	if err != nil {
+		return err
+	}
+	partial, offset, err := openPartial(partialPath, file.Size)
+	if err != nil {
+		return err
+	}
+	defer partial.Close()
+	_, err = protodelim.MarshalTo(stream, &p2pproto.FileResponse{Offset: offset})
+	if err != nil {
+		return err
+	}
+
+	err = partial.appendFrom(reader, file.Size-offset)
+	if err != nil {
+		return fmt.Errorf("failed to receive '%s': %w", file.Path, err)
+	}
+	err = partial.complete(fullPath, file.Sha256, fs.FileMode(file.Mode))
+	if err != nil {
+		return err
+	}
+
+	_, err = protodelim.MarshalTo(stream, &p2pproto.FileResponse{})
+	return err
+}
+
+//
+// Client side of the file transfer protocol
+//
+
+// fileStream is a file transfer stream to an instance
+type fileStream struct {
+	network.Stream
+	reader *bufio.Reader
+	stop   func() bool
+}
+
+func (fst *fileStream) close() {
+	fst.stop()
+	fst.Stream.Close()
+}
+
+func (fst *fileStream) readResponse() (*p2pproto.FileResponse, error) {
+	resp := &p2pproto.FileResponse{}
+	err := protodelim.UnmarshalOptions{MaxSize: maxFileMessageSize}.UnmarshalFrom(fst.reader, resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != "" {
+		return nil, errors.New(resp.Error)
+	}
+	return resp, nil
+}
+
+// openFileStream opens a stream to an instance and sends a file request
+func (p2p *P2P) openFileStream(ctx context.Context, instanceName string, req *p2pproto.FileRequest) (*fileStream, error) {
+	client, err := p2p.GetClient(instanceName)
+	if err != nil {
+		return nil, err
+	}
+	if !p2p.peerSupports(client.peer, FeatureFiles) {
+		return nil, fmt.Errorf("instance '%s' doesn't support file transfers and needs to be upgraded", instanceName)
+	}
+	stream, err := p2p.getHost().NewStream(ctx, client.peer, protocol.ID(protosFilesProtocol))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file transfer stream to instance '%s': %w", instanceName, err)
+	}
+	fst := &fileStream{Stream: stream, reader: bufio.NewReader(stream)}
+	// long transfers are interrupted when the context is cancelled
+	fst.stop = context.AfterFunc(ctx, func() { stream.Reset() })
+
+	_, err = protodelim.MarshalTo(stream, req)
+	if err != nil {
+		fst.close()
+		return nil, fmt.Errorf("failed to send file request to instance '%s': %w", instanceName, err)
+	}
+	return fst, nil
+}
+
+// ListFiles returns a path from the volume of an app and, if the path is a directory, all the files under it
+func (p2p *P2P) ListFiles(ctx context.Context, instanceName string, appName string, remotePath string) ([]*p2pproto.FileInfo, error) {
+	fst, err := p2p.openFileStream(ctx, instanceName, &p2pproto.FileRequest{Operation: FileOpList, AppName: appName, Path: remotePath})
+	if err != nil {
+		return nil, err
+	}
+	defer fst.close()
+
+	resp, err := fst.readResponse()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list '%s' for app '%s': %w", remotePath, appName, err)
+	}
+	return resp.Files, nil
+}
+
+// transferRoot returns the destination of a copy, following the semantics of cp: if the destination is an existing
+// directory, the source is copied inside it
+func transferRoot(source string, destination string, destinationIsDir bool, appName string) string {
+	if !destinationIsDir {
+		return destination
+	}
+	base := path.Base(filepath.ToSlash(source))
+	if base == "." || base == "/" {
+		base = appName
+	}
+	return path.Join(filepath.ToSlash(destination), base)
+}
+
+// PullFiles copies a file or a directory from the volume of an app to a local path
+func (p2p *P2P) PullFiles(ctx context.Context, instanceName string, appName string, remotePath string, localPath string) (TransferStats, error) {
+	stats := TransferStats{}
+	files, err := p2p.ListFiles(ctx, instanceName, appName, remotePath)
+	if err != nil {
+		return stats, err
+	}
+	if len(files) == 0 {
+		return stats, fmt.Errorf("'%s' does not exist in the volume of app '%s'", remotePath, appName)
+	}
+
+	localInfo, err := os.Stat(localPath)
+	root := filepath.FromSlash(transferRoot(remotePath, localPath, err == nil && localInfo.IsDir(), appName))
+	remoteRoot := files[0].Path
+	for _, file := range files {
+		relPath, err := filepath.Rel(filepath.FromSlash(remoteRoot), filepath.FromSlash(file.Path))
+		if err != nil {
+			return stats, err
+		}
+		// the paths are provided by the instance, so they can't be trusted to stay under the destination
+		if !filepath.IsLocal(relPath) {
+			return stats, fmt.Errorf("instance '%s' returned path '%s' which is outside of '%s'", instanceName, file.Path, remotePath)
+		}
+		destination := filepath.Join(root, relPath)
+		if file.IsDir {
+			if err := os.MkdirAll(destination, 0755); err != nil {
+				return stats, fmt.Errorf("failed to create directory '%s': %w", destination, err)
+			}
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(destination), 0755); err != nil {
+			return stats, fmt.Errorf("failed to create directory '%s': %w", filepath.Dir(destination), err)
+		}
+
+		transferred, err := p2p.pullFile(ctx, instanceName, appName, file.Path, destination)
+		if err != nil {
+			return stats, err
+		}
+		stats.Files++
+		stats.Bytes += transferred
+	}
+	return stats, nil
+}
+
+// pullFile downloads a single file, resuming a previous download of the same file
+func (p2p *P2P) pullFile(ctx context.Context, instanceName string, appName string, remotePath string, destination string) (int64, error) {
+	partial, offset, err := openPartial(destination+partialFileSuffix, 1<<62)
+	if err != nil {
+		return 0, fmt.Errorf("failed to pull '%s' from app '%s': %w", remotePath, appName, err)
+	}
+	defer partial.Close()
+
+	fst, err := p2p.openFileStream(ctx, instanceName, &p2pproto.FileRequest{Operation: FileOpPull, AppName: appName, Path: remotePath, Offset: offset})
+	if err != nil {
+		return 0, err
+	}
+	defer fst.close()
+
+	resp, err := fst.readResponse()
+	if err != nil {
+		return 0, fmt.Errorf("failed to pull '%s' from app '%s': %w", remotePath, appName, err)
+	}
+	if len(resp.Files) != 1 {
+		return 0, fmt.Errorf("failed to pull '%s' from app '%s': invalid response", remotePath, appName)
+	}
+	file := resp.Files[0]
+	if resp.Offset != offset {
+		if err := partial.truncate(resp.Offset); err != nil {
+			return 0, fmt.Errorf("failed to pull '%s' from app '%s': %w", remotePath, appName, err)
+		}
+		offset = resp.Offset
+	}
+
+	log.Debugf("Pulling '%s' from app '%s' starting at %d/%d bytes", remotePath, appName, offset, file.Size)
+	err = partial.appendFrom(fst.reader, file.Size-offset)
+	if err != nil {
+		return 0, fmt.Errorf("failed to pull '%s' from app '%s': %w", remotePath, appName, err)
+	}
+	err = partial.complete(destination, file.Sha256, fs.FileMode(file.Mode))
+	if err != nil {
+		return 0, err
+	}
+	return file.Size - offset, nil
+}
+
+// PushFiles copies a local file or directory to the volume of an app
+func (p2p *P2P) PushFiles(ctx context.Context, instanceName string, appName string, localPath string, remotePath string) (TransferStats, error) {
+	stats := TransferStats{}
+	if _, err := os.Stat(localPath); err != nil {
+		return stats, err
+	}
+
+	remoteFiles, err := p2p.ListFiles(ctx, instanceName, appName, remotePath)
+	if err != nil {
+		return stats, err
+	}
+	remoteIsDir := len(remoteFiles) > 0 && remoteFiles[0].IsDir
+	root := transferRoot(localPath, remotePath, remoteIsDir, appName)
+
+	err = filepath.Walk(localPath, func(filePath string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() || strings.HasSuffix(filePath, partialFileSuffix) {
+			return nil
+		}
+		relPath, err := filepath.Rel(localPath, filePath)
+		if err != nil {
+			return err
+		}
+
+		transferred, err := p2p.pushFile(ctx, instanceName, appName, filePath, info, path.Join(root, filepath.ToSlash(relPath)))
+		if err != nil {
+			return err
+		}
+		stats.Files++
+		stats.Bytes += transferred
+		return nil
+	})
+	return stats, err
+}
+
+// pushFile uploads a single file. The server returns the size of a previous partial upload, which is resumed
+func (p2p *P2P) pushFile(ctx context.Context, instanceName string, appName string, localPath string, info fs.FileInfo, remotePath string) (int64, error) {
+	checksum, err := fileChecksum(localPath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to compute checksum for '%s': %w", localPath, err)
+	}
+	file := fileInfo(remotePath, info)
+	file.Sha256 = checksum
+
+	fst, err := p2p.openFileStream(ctx, instanceName, &p2pproto.FileRequest{Operation: FileOpPush, AppName: appName, Path: remotePath, File: file})
+	if err != nil {
+		return 0, err
+	}
+	defer fst.close()
+
+	resp, err := fst.readResponse()
+	if err != nil {
+		return 0, fmt.Errorf("failed to push '%s' to app '%s': %w", localPath, appName, err)
+	}
+	if resp.Offset < 0 || resp.Offset > file.Size {
+		return 0, fmt.Errorf("failed to push '%s' to app '%s': invalid offset %d", localPath, appName, resp.Offset)
+	}
+
+	localFile, err := os.Open(localPath)
+	if err != nil {
+		return 0, err
+	}
+	defer localFile.Close()
+	if _, err := localFile.Seek(resp.Offset, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	log.Debugf("Pushing '%s' to app '%s' starting at %d/%d bytes", localPath, appName, resp.Offset, file.Size)
+	_, err = io.CopyN(fst, localFile, file.Size-resp.Offset)
+	if err != nil {
+		return 0, fmt.Errorf("failed to push '%s' to app '%s': %w", localPath, appName, err)
+	}
+	if err := fst.CloseWrite(); err != nil {
+		return 0, fmt.Errorf("failed to push '%s' to app '%s': %w", localPath, appName, err)
+	}
+
+	_, err = fst.readResponse()
+	if err != nil {
+		return 0, fmt.Errorf("failed to push '%s' to app '%s': %w", localPath, appName, err)
+	}
+	return file.Size - resp.Offset, nil
+}
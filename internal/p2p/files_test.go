@@ -0,0 +1,78 @@
+package p2p
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestConfinePath(t *testing.T) {
+	root := t.TempDir()
+	outside := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "dir"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(outside, filepath.Join(root, "escape")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join(root, "dir"), filepath.Join(root, "inside")); err != nil {
+		t.Fatal(err)
+	}
+
+	allowed := map[string]string{
+		"":                  root,
+		"/":                 root,
+		"dir/file":          filepath.Join(root, "dir", "file"),
+		"../../etc/passwd":  filepath.Join(root, "etc", "passwd"),
+		"dir/../new/file":   filepath.Join(root, "new", "file"),
+		"inside/new":        filepath.Join(root, "inside", "new"),
+		"/dir/./nested/new": filepath.Join(root, "dir", "nested", "new"),
+	}
+	for p, expected := range allowed {
+		confined, err := confinePath(root, p)
+		if err != nil {
+			t.Errorf("path '%s' should be allowed: %s", p, err.Error())
+			continue
+		}
+		if confined != expected {
+			t.Errorf("path '%s' resolved to '%s' instead of '%s'", p, confined, expected)
+		}
+	}
+
+	for _, p := range []string{"escape", "escape/file", "escape/new/file", "inside/../escape/file"} {
+		if _, err := confinePath(root, p); err == nil {
+			t.Errorf("path '%s' points outside the volume and should be rejected", p)
+		}
+	}
+}
+
+func TestOpenPartial(t *testing.T) {
+	root := t.TempDir()
+	target := filepath.Join(t.TempDir(), "target")
+	if err := os.WriteFile(target, []byte("outside"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	partialPath := filepath.Join(root, "file"+partialFileSuffix)
+	if err := os.Symlink(target, partialPath); err != nil {
+		t.Fatal(err)
+	}
+
+	partial, offset, err := openPartial(partialPath, 100)
+	if err != nil {
+		t.Fatalf("failed to open partial: %s", err.Error())
+	}
+	defer partial.Close()
+	if offset != 0 {
+		t.Errorf("symlinked partial should not be resumed, got offset %d", offset)
+	}
+	if err := partial.appendFrom(strings.NewReader("data"), 4); err != nil {
+		t.Fatal(err)
+	}
+	if content, _ := os.ReadFile(target); string(content) != "outside" {
+		t.Errorf("symlink target was written to: '%s'", string(content))
+	}
+	if info, err := os.Lstat(partialPath); err != nil || !info.Mode().IsRegular() {
+		t.Errorf("partial should be a regular file")
+	}
+}
@@ -0,0 +1,203 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/semver"
+	p2pgrpc "github.com/birros/go-libp2p-grpc"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/protosio/protos/internal/db"
+	p2pproto "github.com/protosio/protos/internal/p2p/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Features that are advertised during the handshake, so that peers only use the functionality supported by both sides
+const (
+	FeatureEvents       = "events"
+	FeatureFiles        = "files"
+	FeaturePeerStates   = "peer-states"
+	FeatureLANDiscovery = "lan-discovery"
+	FeaturePortForward  = "port-forward"
+)
+
+const handshakeTimeout = 10 * time.Second
+
+var supportedFeatures = []string{FeatureEvents, FeatureFiles, FeaturePeerStates, FeatureLANDiscovery, FeaturePortForward}
+
+// peerHandshake holds the versions advertised by a peer. If the peer is not compatible, err holds the reason
+type peerHandshake struct {
+	version       string
+	features      []string
+	schemaVersion int32
+	err           error
+}
+
+func (hs *peerHandshake) hasFeature(feature string) bool {
+	for _, f := range hs.features {
+		if f == feature {
+			return true
+		}
+	}
+	return false
+}
+
+func (peer *rpcPeer) getHandshake() *peerHandshake {
+	peer.mu.Lock()
+	defer peer.mu.Unlock()
+	return peer.handshake
+}
+
+func (peer *rpcPeer) setHandshake(hs *peerHandshake) {
+	peer.mu.Lock()
+	defer peer.mu.Unlock()
+	peer.handshake = hs
+}
+
+// upgradeSide returns which side of a connection runs the older version
+func upgradeSide(remoteIsOlder bool) string {
+	if remoteIsOlder {
+		return "the peer needs to be upgraded"
+	}
+	return "this machine needs to be upgraded"
+}
+
+// CheckVersionCompatibility checks if a remote version of protos can work with the local one. Versions with a
+// different major number are incompatible, and before 1.0 the minor number has to match as well. Development builds,
+// which don't use semantic versions, are considered compatible with any version
+func CheckVersionCompatibility(local string, remote string) error {
+	localVersion, err := semver.NewVersion(local)
+	if err != nil {
+		return nil
+	}
+	remoteVersion, err := semver.NewVersion(remote)
+	if err != nil {
+		return nil
+	}
+
+	if localVersion.Major() != remoteVersion.Major() || (localVersion.Major() == 0 && localVersion.Minor() != remoteVersion.Minor()) {
+		return fmt.Errorf("peer runs protos %s, which is incompatible with the local version %s: %s", remote, local, upgradeSide(remoteVersion.LessThan(localVersion)))
+	}
+	return nil
+}
+
+// checkCompatibility checks the versions advertised by a peer during the handshake
+func (p2p *P2P) checkCompatibility(version string, schemaVersion int32) error {
+	err := CheckVersionCompatibility(p2p.version, version)
+	if err != nil {
+		return err
+	}
+	if schemaVersion != db.SchemaVersion {
+		return fmt.Errorf("peer uses db schema version %d, which is incompatible with the local schema version %d: %s", schemaVersion, db.SchemaVersion, upgradeSide(schemaVersion < db.SchemaVersion))
+	}
+	return nil
+}
+
+// findPeer returns the peer for a peer ID, following the aliases used during key rotations
+func (p2p *P2P) findPeer(peerID peer.ID) (*rpcPeer, bool) {
+	rpcpeer, found := p2p.peers.Get(peerID.String())
+	if !found {
+		if primaryID, aliased := p2p.aliases.Get(peerID.String()); aliased {
+			rpcpeer, found = p2p.peers.Get(primaryID)
+		}
+	}
+	return rpcpeer, found
+}
+
+// peerIncompatibility returns the reason a peer is incompatible, or nil if it's compatible or the handshake didn't
+// happen yet
+func (p2p *P2P) peerIncompatibility(peerID peer.ID) error {
+	rpcpeer, found := p2p.findPeer(peerID)
+	if !found {
+		return nil
+	}
+	if hs := rpcpeer.getHandshake(); hs != nil {
+		return hs.err
+	}
+	return nil
+}
+
+// peerSupports checks if a peer advertised a feature. Peers that didn't complete the handshake yet are assumed to
+// support it
+func (p2p *P2P) peerSupports(peerID peer.ID, feature string) bool {
+	rpcpeer, found := p2p.findPeer(peerID)
+	if !found {
+		return true
+	}
+	hs := rpcpeer.getHandshake()
+	return hs == nil || hs.hasFeature(feature)
+}
+
+// handshake exchanges versions with a newly connected peer, and records if the peer is compatible
+func (p2p *P2P) handshake(rpcpeer *rpcPeer, client *Client) error {
+	ctx, cancel := context.WithTimeout(context.Background(), handshakeTimeout)
+	defer cancel()
+
+	resp, err := client.Handshake(ctx, &p2pproto.HandshakeRequest{
+		Version:       p2p.version,
+		Features:      supportedFeatures,
+		SchemaVersion: db.SchemaVersion,
+	})
+	if err != nil {
+		if status.Code(err) != codes.Unimplemented {
+			return fmt.Errorf("handshake failed: %w", err)
+		}
+		err = fmt.Errorf("peer runs a version of protos without protocol negotiation: the peer needs to be upgraded")
+		rpcpeer.setHandshake(&peerHandshake{err: err})
+		return err
+	}
+
+	hs := &peerHandshake{
+		version:       resp.Version,
+		features:      resp.Features,
+		schemaVersion: resp.SchemaVersion,
+		err:           p2p.checkCompatibility(resp.Version, resp.SchemaVersion),
+	}
+	rpcpeer.setHandshake(hs)
+	return hs.err
+}
+
+// CheckPeerCompatibility checks if an instance is compatible with the local machine. If the handshake with the
+// instance didn't happen, the version stored in the db is used
+func (p2p *P2P) CheckPeerCompatibility(name string, knownVersion string) error {
+	for _, rpcpeer := range p2p.peers.Snapshot() {
+		machine := rpcpeer.GetMachine()
+		if machine == nil || machine.GetName() != name {
+			continue
+		}
+		if hs := rpcpeer.getHandshake(); hs != nil {
+			return hs.err
+		}
+	}
+	return CheckVersionCompatibility(p2p.version, knownVersion)
+}
+
+// Handshake records the versions advertised by a peer, and replies with the local ones. Both sides decide on their
+// own if they are compatible, and calls from incompatible peers are refused by the authorization interceptor
+func (s *Server) Handshake(ctx context.Context, req *p2pproto.HandshakeRequest) (*p2pproto.HandshakeResponse, error) {
+	peerID, ok := p2pgrpc.RemotePeerFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "no remote peer in context")
+	}
+
+	hs := &peerHandshake{
+		version:       req.Version,
+		features:      req.Features,
+		schemaVersion: req.SchemaVersion,
+		err:           s.p2p.checkCompatibility(req.Version, req.SchemaVersion),
+	}
+	if hs.err != nil {
+		log.Warnf("Peer '%s' is incompatible: %s", peerID.String(), hs.err.Error())
+	}
+	if rpcpeer, found := s.p2p.findPeer(peerID); found {
+		rpcpeer.setHandshake(hs)
+	}
+
+	return &p2pproto.HandshakeResponse{
+		Version:       s.p2p.version,
+		Features:      supportedFeatures,
+		SchemaVersion: db.SchemaVersion,
+	}, nil
+}
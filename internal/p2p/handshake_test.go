@@ -0,0 +1,28 @@
+package p2p
+
+import "testing"
+
+func TestCheckVersionCompatibility(t *testing.T) {
+	compatible := [][2]string{
+		{"0.1.0-dev.23", "0.1.0-dev.20"},
+		{"0.1.0", "0.1.5"},
+		{"1.2.0", "1.0.3"},
+		{"0.1.0", "dev"},
+	}
+	for _, versions := range compatible {
+		if err := CheckVersionCompatibility(versions[0], versions[1]); err != nil {
+			t.Errorf("versions %s and %s should be compatible: %s", versions[0], versions[1], err.Error())
+		}
+	}
+
+	incompatible := [][2]string{
+		{"0.1.0", "0.2.0"},
+		{"1.0.0", "2.0.0"},
+		{"1.0.0", "0.9.0"},
+	}
+	for _, versions := range incompatible {
+		if err := CheckVersionCompatibility(versions[0], versions[1]); err == nil {
+			t.Errorf("versions %s and %s should be incompatible", versions[0], versions[1])
+		}
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"net"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	p2pgrpc "github.com/birros/go-libp2p-grpc"
@@ -13,10 +15,14 @@ import (
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/host/autorelay"
 	connmgr "github.com/libp2p/go-libp2p/p2p/net/connmgr"
 	noise "github.com/libp2p/go-libp2p/p2p/security/noise"
-	quic "github.com/libp2p/go-libp2p/p2p/transport/quic"
+	"github.com/libp2p/go-libp2p/p2p/transport/quicreuse"
 	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/protosio/protos/internal/capability"
+	"github.com/protosio/protos/internal/config"
 	p2pproto "github.com/protosio/protos/internal/p2p/proto"
 	"github.com/protosio/protos/internal/pcrypto"
 	"github.com/protosio/protos/internal/util"
@@ -27,28 +33,73 @@ import (
 var log = util.GetLogger("p2p")
 
 const (
-	protosRPCProtocol              = "/protos/rpc/0.0.1"
-	protosUpdatesTopic             = "/protos/updates/0.0.1"
-	destinationStringTemplate      = "/ip4/%s/udp/%d/quic-v1/%s"
-	p2pPort                   uint = 10500
-	initMachineName                = "initMachine"
+	protosRPCProtocol          = "/protos/rpc/0.0.1"
+	protosUpdatesTopic         = "/protos/updates/0.0.1"
+	protosFilesProtocol        = "/protos/files/0.0.1"
+	protosPortFwdProtocol      = "/protos/portfwd/0.0.1"
+	p2pPort               uint = 10500
+	initMachineName            = "initMachine"
+	endpointRefreshDelay       = 2 * time.Second
 )
 
 type AppManager interface {
 	GetLogs(name string) ([]byte, error)
 	GetStatus(name string) (string, error)
+	GetVolumePath(name string) (string, error)
+	GetIP(name string) (net.IP, error)
 }
 
+// Machine types, used to determine what a peer is allowed to do
+const (
+	MachineTypeInstance = "instance"
+	MachineTypeDevice   = "device"
+)
+
 type Machine interface {
 	GetPublicKey() string
 	GetPublicIP() string
 	GetName() string
+	GetMachineID() string // identifies the machine in key rotations
+	GetType() string
+	GetMultiaddrs() []string
+}
+
+// NetworkProvider returns the WireGuard statistics of the peers, indexed by their WireGuard public key, and tells which
+// addresses are only reachable over WireGuard
+type NetworkProvider interface {
+	GetPeerStats() (map[string]*p2pproto.WireguardStatus, error)
+	IsMeshIP(ip net.IP) bool
+}
+
+// PeerConfigurator is notified when the endpoints of the peers change
+type PeerConfigurator interface {
+	Refresh() error
+}
+
+// KeyResolver resolves the public key of a peer, following its key rotations. It returns the key that is currently
+// active and all the keys that are accepted during the overlap window of a rotation
+type KeyResolver interface {
+	ResolvePublicKey(machineID string, publicKey string) (string, []string)
+}
+
+// PeerEndpoint describes how a peer can be reached, as discovered via its libp2p connections. When no direct path
+// is available, Relay holds the public key of the peer that relays the traffic
+type PeerEndpoint struct {
+	IP    net.IP
+	Relay string
+}
+
+// Equal checks if two peer endpoints are the same
+func (pe PeerEndpoint) Equal(other PeerEndpoint) bool {
+	return pe.IP.Equal(other.IP) && pe.Relay == other.Relay
 }
 
 type rpcPeer struct {
-	mu      sync.Mutex
-	machine Machine
-	client  *Client
+	mu        sync.Mutex
+	machine   Machine
+	client    *Client
+	status    peerStatus
+	handshake *peerHandshake
 }
 
 func (peer *rpcPeer) GetClient() *Client {
@@ -83,15 +134,44 @@ type Client struct {
 	p2pproto.InstanceClient
 
 	peer peer.ID
+	conn *grpc.ClientConn
+}
+
+// resolvedMachine overrides the public key of a machine with its currently active key
+type resolvedMachine struct {
+	Machine
+	publicKey string
+}
+
+func (rm resolvedMachine) GetPublicKey() string {
+	return rm.publicKey
 }
 
 type P2P struct {
-	host        host.Host
-	peers       *util.Map[string, *rpcPeer]
-	appManager  AppManager
-	grpcServer  *grpc.Server
-	newPeerChan chan peer.AddrInfo
-	initMode    bool
+	hostMu            sync.RWMutex
+	host              host.Host
+	peers             *util.Map[string, *rpcPeer]
+	aliases           *util.Map[string, string]
+	endpoints         *util.Map[string, PeerEndpoint]
+	appManager        AppManager
+	networkManager    NetworkProvider
+	peerConfigurator  PeerConfigurator
+	keyResolver       KeyResolver
+	relayService      bool
+	transports        config.P2PConfig
+	capabilityManager *capability.Manager
+	grpcServer        *grpc.Server
+	grpcCancel        context.CancelFunc
+	quicReuse         *quicreuse.ConnManager
+	listenAddrs       []multiaddr.Multiaddr
+	retiring          *util.Map[string, *retiringHost]
+	newPeerChan       chan peer.AddrInfo
+	refreshChan       chan struct{}
+	initMode          atomic.Bool
+	version           string
+	events            *eventBus
+	updates           *updatesTopic
+	lanDiscovery      *lanDiscovery
 
 	externalDB ExternalDB
 }
@@ -100,6 +180,13 @@ func (p2p *P2P) GetGRPCServer() *grpc.Server {
 	return p2p.grpcServer
 }
 
+// getHost returns the libp2p host, which is replaced when the identity of the local machine is rotated
+func (p2p *P2P) getHost() host.Host {
+	p2p.hostMu.RLock()
+	defer p2p.hostMu.RUnlock()
+	return p2p.host
+}
+
 // GetPeerID adds a peer to the p2p manager
 func (p2p *P2P) PubKeyToPeerID(pubKey []byte) (string, error) {
 	pk, err := crypto.UnmarshalEd25519PublicKey(pubKey)
@@ -113,11 +200,49 @@ func (p2p *P2P) PubKeyToPeerID(pubKey []byte) (string, error) {
 	return peerID.String(), nil
 }
 
+// publicKeyToPeerID converts a base64 encoded public key to a peer ID
+func publicKeyToPeerID(publicKey string) (peer.ID, error) {
+	pubKeyBytes, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode public key: %w", err)
+	}
+
+	pk, err := crypto.UnmarshalEd25519PublicKey(pubKeyBytes)
+	if err != nil {
+		return "", fmt.Errorf("failed to unmarshall public key: %w", err)
+	}
+	peerID, err := peer.IDFromPublicKey(pk)
+	if err != nil {
+		return "", fmt.Errorf("failed to create peer ID from public key: %w", err)
+	}
+	return peerID, nil
+}
+
+// PublicKeyToPeerID converts a base64 encoded public key to the peer ID used by the machine that owns the key
+func PublicKeyToPeerID(publicKey string) (string, error) {
+	peerID, err := publicKeyToPeerID(publicKey)
+	if err != nil {
+		return "", err
+	}
+	return peerID.String(), nil
+}
+
+// ResolvePublicKey returns the currently active key for a peer, and all the keys that are accepted for it
+func (p2p *P2P) ResolvePublicKey(machineID string, publicKey string) (string, []string) {
+	if p2p.keyResolver == nil {
+		return publicKey, []string{publicKey}
+	}
+	return p2p.keyResolver.ResolvePublicKey(machineID, publicKey)
+}
+
 func (p2p *P2P) GetClient(name string) (*Client, error) {
 	for _, rpcpeer := range p2p.peers.Snapshot() {
 		client := rpcpeer.GetClient()
 		machine := rpcpeer.GetMachine()
 		if machine != nil && client != nil && machine.GetName() == name {
+			if hs := rpcpeer.getHandshake(); hs != nil && hs.err != nil {
+				return nil, fmt.Errorf("instance '%s' is incompatible: %w", name, hs.err)
+			}
 			return client, nil
 		}
 	}
@@ -141,7 +266,7 @@ func (p2p *P2P) createClientForPeer(peerID peer.ID) (client *Client, err error)
 	conn, err := grpc.Dial(
 		peerID.String(),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		p2pgrpc.WithP2PDialer(p2p.host, protosRPCProtocol),
+		p2pgrpc.WithP2PDialer(p2p.getHost(), protosRPCProtocol),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to grpc dial peer '%s': %w", peerID.String(), err)
@@ -153,6 +278,7 @@ func (p2p *P2P) createClientForPeer(peerID peer.ID) (client *Client, err error)
 		AppsClient:     p2pproto.NewAppsClient(conn),
 		InstanceClient: p2pproto.NewInstanceClient(conn),
 		peer:           peerID,
+		conn:           conn,
 	}
 
 	tries := 0
@@ -182,17 +308,47 @@ func (p2p *P2P) createClientForPeer(peerID peer.ID) (client *Client, err error)
 
 // ConfigurePeers configures all the peers passed as arguemnt
 func (p2p *P2P) ConfigurePeers(machines []Machine) error {
-	currentPeers := map[string]peer.ID{}
+	currentPeers := map[string]bool{}
+	aliases := map[string]string{}
 	log.Debugf("Configuring p2p peers")
 
 	// add new peers
 	for _, machine := range machines {
-		client, err := p2p.AddPeer(machine)
+		publicKey, acceptedKeys := p2p.ResolvePublicKey(machine.GetMachineID(), machine.GetPublicKey())
+		peerID, err := publicKeyToPeerID(publicKey)
+		if err != nil {
+			log.Errorf("Failed to add peer '%s': %s", machine.GetName(), err.Error())
+			continue
+		}
+		currentPeers[peerID.String()] = true
+
+		// during a key rotation, the peer is also accepted with its other keys
+		for _, acceptedKey := range acceptedKeys {
+			if acceptedKey == publicKey {
+				continue
+			}
+			aliasID, err := publicKeyToPeerID(acceptedKey)
+			if err != nil {
+				continue
+			}
+			currentPeers[aliasID.String()] = true
+			aliases[aliasID.String()] = peerID.String()
+		}
+
+		_, err = p2p.AddPeer(resolvedMachine{Machine: machine, publicKey: publicKey})
 		if err != nil {
 			log.Errorf("Failed to add peer '%s': %s", machine.GetName(), err.Error())
 			continue
 		}
-		currentPeers[client.peer.String()] = client.peer
+	}
+
+	for aliasID := range p2p.aliases.Snapshot() {
+		if _, found := aliases[aliasID]; !found {
+			p2p.aliases.Delete(aliasID)
+		}
+	}
+	for aliasID, peerID := range aliases {
+		p2p.aliases.Set(aliasID, peerID)
 	}
 
 	// delete old peers
@@ -210,7 +366,7 @@ func (p2p *P2P) ConfigurePeers(machines []Machine) error {
 			p2p.peers.Delete(id)
 			client := rpcpeer.GetClient()
 			if client != nil {
-				err := p2p.host.Network().ClosePeer(client.peer)
+				err := p2p.getHost().Network().ClosePeer(client.peer)
 				if err != nil {
 					log.Debugf("Failed to disconnect from old peer '%s'(%s)", id, name)
 				}
@@ -223,46 +379,24 @@ func (p2p *P2P) ConfigurePeers(machines []Machine) error {
 
 // AddPeer adds a peer to the p2p manager
 func (p2p *P2P) AddPeer(machine Machine) (*Client, error) {
-	pubKeyBytes, err := base64.StdEncoding.DecodeString(machine.GetPublicKey())
-	if err != nil {
-		return nil, fmt.Errorf("failed to decode public key: %w", err)
-	}
-
-	pk, err := crypto.UnmarshalEd25519PublicKey(pubKeyBytes)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshall public key: %w", err)
-	}
-	peerID, err := peer.IDFromPublicKey(pk)
+	peerID, err := publicKeyToPeerID(machine.GetPublicKey())
 	if err != nil {
-		return nil, fmt.Errorf("failed to create peer ID from public key: %w", err)
+		return nil, err
 	}
 
 	rpcpeer, found := p2p.peers.Get(peerID.String())
 	if !found {
-		destinationString := ""
-		if machine.GetPublicIP() != "" {
-			destinationString = fmt.Sprintf(destinationStringTemplate, machine.GetPublicIP(), p2pPort, peerID.String())
-		} else {
-			destinationString = fmt.Sprintf("/p2p/%s", peerID.String())
-		}
-		maddr, err := multiaddr.NewMultiaddr(destinationString)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create multi address: %w", err)
-		}
-
-		peerInfo, err := peer.AddrInfoFromP2pAddr(maddr)
-		if err != nil {
-			return nil, fmt.Errorf("failed to extract info from address: %w", err)
-		}
+		peerInfo := p2p.peerAddrInfo(peerID, machine)
 		rpcpeer := &rpcPeer{machine: machine}
 		p2p.peers.Set(peerID.String(), rpcpeer)
 
 		log.Debugf("Adding peer id '%s'(%s) at ip '%s'", machine.GetName(), peerInfo.ID.String(), machine.GetPublicIP())
 
-		err = p2p.host.Connect(context.Background(), *peerInfo)
+		// if the first attempt fails, the peer supervisor keeps trying to connect
+		err = p2p.getHost().Connect(context.Background(), peerInfo)
 		if err != nil {
 			log.Errorf("Failed to connect to peer '%s'(%s): %s", machine.GetName(), peerID.String(), err.Error())
-
+			rpcpeer.markDialFailed(err)
 		}
 
 		client, err := p2p.createClientForPeer(peerID)
@@ -282,6 +416,16 @@ func (p2p *P2P) AddPeer(machine Machine) (*Client, error) {
 	return rpcpeer.GetClient(), nil
 }
 
+// peerAddrInfo returns the addresses that are used to connect to a peer
+func (p2p *P2P) peerAddrInfo(peerID peer.ID, machine Machine) peer.AddrInfo {
+	peerInfo := peer.AddrInfo{ID: peerID, Addrs: dialAddrs(machine)}
+	if len(peerInfo.Addrs) == 0 {
+		// peers without a public address are reached via the relays, and hole punching upgrades the connection
+		peerInfo.Addrs = p2p.circuitAddrs(peerID)
+	}
+	return peerInfo
+}
+
 func (p2p *P2P) newConnectionHandler(netw network.Network, conn network.Conn) {
 	go func() {
 		if conn.Stat().Transient {
@@ -290,11 +434,15 @@ func (p2p *P2P) newConnectionHandler(netw network.Network, conn network.Conn) {
 
 		var rpcpeer *rpcPeer
 		var machine Machine
-		if p2p.initMode {
+		if p2p.initMode.Load() {
 			machine = &initMachine{name: initMachineName}
 			rpcpeer = &rpcPeer{machine: machine}
 		} else {
-			rpcpeer, found := p2p.peers.Get(conn.RemotePeer().String())
+			var found bool
+			rpcpeer, found = p2p.peers.Get(conn.RemotePeer().String())
+			if !found {
+				rpcpeer, found = p2p.aliasPeer(conn.RemotePeer())
+			}
 			if !found {
 				log.Errorf("Peer '%s' not recognized while creating client", conn.RemotePeer().String())
 				conn.Close()
@@ -317,6 +465,20 @@ func (p2p *P2P) newConnectionHandler(netw network.Network, conn network.Conn) {
 			return
 		}
 		rpcpeer.SetClient(rpcClient)
+
+		// incompatible peers stay connected, so that their state is visible, but their db is not synchronised
+		err = p2p.handshake(rpcpeer, rpcClient)
+		if err != nil {
+			log.Errorf("Refusing peer '%s'(%s): %s", machine.GetName(), conn.RemotePeer().String(), err.Error())
+		} else if p2p.externalDB != nil && !p2p.initMode.Load() {
+			if err := p2p.externalDB.AddPeer(conn.RemotePeer().String(), rpcClient.conn); err != nil {
+				log.Errorf("Failed to add DB peer for '%s': %v", conn.RemotePeer().String(), err)
+			}
+		}
+
+		rpcpeer.markConnected()
+		p2p.updateEndpoint(conn.RemotePeer())
+		p2p.peerEvent(conn.RemotePeer(), machine.GetName(), true)
 	}()
 }
 
@@ -324,12 +486,54 @@ func (p2p *P2P) newConnectionHandler(netw network.Network, conn network.Conn) {
 // Methods for handling peer removal
 //
 
+// aliasPeer creates a peer for a connection that uses one of the other keys of a known peer, which happens while
+// the peer rotates its key
+func (p2p *P2P) aliasPeer(peerID peer.ID) (*rpcPeer, bool) {
+	primaryID, found := p2p.aliases.Get(peerID.String())
+	if !found {
+		return nil, false
+	}
+	primary, found := p2p.peers.Get(primaryID)
+	if !found {
+		return nil, false
+	}
+
+	log.Infof("Peer '%s' connected using rotated key '%s'", primaryID, peerID.String())
+	rpcpeer := &rpcPeer{machine: primary.GetMachine()}
+	p2p.peers.Set(peerID.String(), rpcpeer)
+
+	// the db might not reflect the new key yet, so the peer configuration is refreshed
+	select {
+	case p2p.refreshChan <- struct{}{}:
+	default:
+	}
+	return rpcpeer, true
+}
+
 func (p2p *P2P) closeConnectionHandler(netw network.Network, conn network.Conn) {
-	log.Infof("Disconnected from %s", conn.RemotePeer().String())
+	// connections of a replaced host are ignored
+	if netw.LocalPeer() != p2p.getHost().ID() {
+		return
+	}
 	if err := conn.Close(); err != nil {
 		log.Errorf("Error while disconnecting from peer '%s': %v", conn.RemotePeer().String(), err)
 	}
-	p2p.peers.Delete(conn.RemotePeer().String())
+	// a peer can have multiple connections, for example while a relayed connection is upgraded
+	if netw.Connectedness(conn.RemotePeer()) == network.Connected {
+		return
+	}
+	log.Infof("Disconnected from %s", conn.RemotePeer().String())
+
+	// the peer is kept, and the supervisor reconnects it
+	name := "unknown"
+	if rpcpeer, found := p2p.peers.Get(conn.RemotePeer().String()); found {
+		rpcpeer.markDisconnected()
+		if rpcpeer.GetMachine() != nil {
+			name = rpcpeer.GetMachine().GetName()
+		}
+	}
+	p2p.peerEvent(conn.RemotePeer(), name, false)
+	p2p.updateEndpoint(conn.RemotePeer())
 	if p2p.externalDB != nil {
 		if err := p2p.externalDB.RemovePeer(conn.RemotePeer().String()); err != nil {
 			log.Errorf("Failed to remove DB peer for '%s': %v", conn.RemotePeer().String(), err)
@@ -338,85 +542,422 @@ func (p2p *P2P) closeConnectionHandler(netw network.Network, conn network.Conn)
 }
 
 //
-// Methods for creating and starting the p2p server
+// Methods for NAT traversal and relaying
 //
 
-// StartServer starts listening for p2p connections
-func (p2p *P2P) StartServer(metaConfigurator MetaConfigurator) (func() error, error) {
-	log.Info("Starting p2p server")
+// relayCandidates returns the connected peers that have a public IP, and can therefore act as relays
+func (p2p *P2P) relayCandidates() []peer.AddrInfo {
+	candidates := []peer.AddrInfo{}
+	h := p2p.getHost()
+	if h == nil {
+		return candidates
+	}
 
-	// register internal grpc servers
-	srv := &Server{DB: p2p.externalDB, metaConfigurator: metaConfigurator, p2p: p2p}
+	for id, rpcpeer := range p2p.peers.Snapshot() {
+		machine := rpcpeer.GetMachine()
+		if machine == nil || machine.GetPublicIP() == "" {
+			continue
+		}
+		peerID, err := peer.Decode(id)
+		if err != nil {
+			continue
+		}
+		if h.Network().Connectedness(peerID) != network.Connected {
+			continue
+		}
+		candidates = append(candidates, h.Peerstore().PeerInfo(peerID))
+	}
+	return candidates
+}
+
+// relayPeerSource is used by the auto relay service to find relays when the host is not publicly reachable
+func (p2p *P2P) relayPeerSource(ctx context.Context, num int) <-chan peer.AddrInfo {
+	candidates := p2p.relayCandidates()
+	if len(candidates) > num {
+		candidates = candidates[:num]
+	}
+
+	peerChan := make(chan peer.AddrInfo, len(candidates))
+	for _, candidate := range candidates {
+		peerChan <- candidate
+	}
+	close(peerChan)
+	return peerChan
+}
+
+// circuitAddrs returns the relayed addresses through which a peer can be reached
+func (p2p *P2P) circuitAddrs(peerID peer.ID) []multiaddr.Multiaddr {
+	addrs := []multiaddr.Multiaddr{}
+	for _, relay := range p2p.relayCandidates() {
+		if relay.ID == peerID {
+			continue
+		}
+		for _, relayAddr := range relay.Addrs {
+			if _, err := relayAddr.ValueForProtocol(multiaddr.P_CIRCUIT); err == nil {
+				continue
+			}
+			maddr, err := multiaddr.NewMultiaddr(fmt.Sprintf("%s/p2p/%s/p2p-circuit", relayAddr.String(), relay.ID.String()))
+			if err != nil {
+				log.Errorf("Failed to create circuit address via relay '%s': %s", relay.ID.String(), err.Error())
+				continue
+			}
+			addrs = append(addrs, maddr)
+		}
+	}
+	return addrs
+}
+
+// GetPeerEndpoint returns the endpoint of a peer, based on its active libp2p connections. Direct connections are
+// preferred and the relay is only returned when the peer can't be reached otherwise
+func (p2p *P2P) GetPeerEndpoint(publicKey string) (PeerEndpoint, bool) {
+	peerID, err := publicKeyToPeerID(publicKey)
+	if err != nil {
+		return PeerEndpoint{}, false
+	}
+
+	return p2p.findPeerEndpoint(peerID)
+}
+
+func (p2p *P2P) findPeerEndpoint(peerID peer.ID) (PeerEndpoint, bool) {
+	// peers on the same LAN are reached directly, to keep the traffic local
+	if endpoint, found := p2p.lanEndpoint(peerID); found {
+		return endpoint, true
+	}
+
+	endpoint := PeerEndpoint{}
+	found := false
+	for _, conn := range p2p.getHost().Network().ConnsToPeer(peerID) {
+		remoteAddr := conn.RemoteMultiaddr()
+		if _, err := remoteAddr.ValueForProtocol(multiaddr.P_CIRCUIT); err == nil {
+			relayID, err := remoteAddr.ValueForProtocol(multiaddr.P_P2P)
+			if err != nil {
+				continue
+			}
+			relayPeer, relayFound := p2p.peers.Get(relayID)
+			if !relayFound || relayPeer.GetMachine() == nil {
+				continue
+			}
+			endpoint.Relay = relayPeer.GetMachine().GetPublicKey()
+			found = true
+			continue
+		}
+
+		ip, err := manet.ToIP(remoteAddr)
+		if err != nil || !p2p.isEndpointIP(ip) {
+			continue
+		}
+		return PeerEndpoint{IP: ip}, true
+	}
+
+	return endpoint, found
+}
+
+// updateEndpoint checks if the endpoint of a peer changed, and if so, triggers a refresh of the peer configuration
+func (p2p *P2P) updateEndpoint(peerID peer.ID) {
+	endpoint, found := p2p.findPeerEndpoint(peerID)
+	previous, existed := p2p.endpoints.Get(peerID.String())
+	if found == existed && endpoint.Equal(previous) {
+		return
+	}
+
+	if found {
+		log.Debugf("Discovered new endpoint for peer '%s': ip '%s', relay '%s'", peerID.String(), endpoint.IP, endpoint.Relay)
+		p2p.endpoints.Set(peerID.String(), endpoint)
+	} else {
+		p2p.endpoints.Delete(peerID.String())
+	}
+
+	select {
+	case p2p.refreshChan <- struct{}{}:
+	default:
+	}
+}
+
+// endpointRefresher refreshes the peer configuration when peer endpoints change. Multiple changes in quick succession
+// result in a single refresh
+func (p2p *P2P) endpointRefresher() {
+	for range p2p.refreshChan {
+		time.Sleep(endpointRefreshDelay)
+		if p2p.peerConfigurator == nil {
+			continue
+		}
+		if err := p2p.peerConfigurator.Refresh(); err != nil {
+			log.Errorf("Failed to refresh peers after endpoint change: %s", err.Error())
+		}
+	}
+}
+
+//
+// Methods for creating and starting the p2p server
+//
+
+// registerServices registers the p2p services with the grpc server. The Tester service is only registered in dev mode
+func (p2p *P2P) registerServices(srv *Server, devMode bool) {
 	p2pproto.RegisterPingerServer(p2p.grpcServer, srv)
-	p2pproto.RegisterTesterServer(p2p.grpcServer, srv)
+	if devMode {
+		p2pproto.RegisterTesterServer(p2p.grpcServer, srv)
+	}
 	p2pproto.RegisterAppsServer(p2p.grpcServer, srv)
 	p2pproto.RegisterInstanceServer(p2p.grpcServer, srv)
+}
+
+// StartServer starts listening for p2p connections. The Tester service, which allows peers to run arbitrary queries,
+// is only available in dev mode
+func (p2p *P2P) StartServer(metaConfigurator MetaConfigurator, devMode bool) (func() error, error) {
+	log.Info("Starting p2p server")
+	p2p.version = metaConfigurator.GetVersion()
+
+	// register internal grpc servers
+	p2p.registerServices(&Server{DB: p2p.externalDB, metaConfigurator: metaConfigurator, p2p: p2p}, devMode)
 
 	// serve grpc server over libp2p host
-	grpcListener := p2pgrpc.NewListener(context.Background(), p2p.host, protosRPCProtocol)
+	p2p.grpcCancel = p2p.serveGRPC(p2p.getHost())
+
+	err := listen(p2p.getHost(), p2p.listenAddrs)
+	if err != nil {
+		return func() error { return nil }, err
+	}
+
+	updates, err := p2p.joinUpdates(p2p.getHost())
+	if err != nil {
+		return func() error { return nil }, err
+	}
+	p2p.hostMu.Lock()
+	p2p.updates = updates
+	p2p.hostMu.Unlock()
+
+	p2p.serveFiles(p2p.getHost())
+	p2p.servePortForwards(p2p.getHost())
+	p2p.lanDiscovery.start(p2p.getHost())
+
+	// the background workers run until the server is stopped
+	ctx, cancel := context.WithCancel(context.Background())
+	go p2p.endpointRefresher()
+	go p2p.peerSupervisor(ctx)
+	go p2p.headWatcher(ctx)
+
+	stopper := func() error {
+		log.Debug("Stopping p2p server")
+		cancel()
+		p2p.lanDiscovery.stop()
+		for _, retiring := range p2p.retiring.Snapshot() {
+			if retiring.timer.Stop() {
+				p2p.retireHost(retiring)
+			}
+		}
+		p2p.grpcServer.GracefulStop()
+
+		p2p.hostMu.RLock()
+		defer p2p.hostMu.RUnlock()
+		p2p.updates.close()
+		err := p2p.host.Close()
+		if cerr := p2p.quicReuse.Close(); cerr != nil {
+			log.Errorf("Failed to release the QUIC sockets: %s", cerr.Error())
+		}
+		return err
+	}
+	return stopper, nil
+
+}
+
+// serveGRPC serves the grpc server over a libp2p host, and returns the function that stops serving it
+func (p2p *P2P) serveGRPC(h host.Host) context.CancelFunc {
+	ctx, cancel := context.WithCancel(context.Background())
+	grpcListener := p2pgrpc.NewListener(ctx, h, protosRPCProtocol)
 	go func() {
 		err := p2p.grpcServer.Serve(grpcListener)
-		if err != nil {
+		if err != nil && ctx.Err() == nil {
 			log.Error("grpc serve error: ", err)
 			panic(err)
 		}
 	}()
+	return cancel
+}
+
+// retiringHost is a previous host, which keeps serving the peers that use its identity until they stop accepting it
+type retiringHost struct {
+	host       host.Host
+	grpcCancel context.CancelFunc
+	updates    *updatesTopic
+	timer      *time.Timer
+}
 
-	err := p2p.host.Network().Listen()
+// RotateIdentity replaces the libp2p host with one that uses the provided key. The listen addresses are moved to the
+// new host, which then reconnects the peers. The previous host keeps its connections and keeps serving the peers
+// until retireAt, which is when the peers stop accepting the previous key. The peers and their endpoints are kept
+func (p2p *P2P) RotateIdentity(key *pcrypto.Key, retireAt time.Time) error {
+	newHost, newQUICReuse, err := p2p.createHost(key)
 	if err != nil {
-		return func() error { return nil }, fmt.Errorf("failed to listen: %w", err)
+		return err
+	}
+	newUpdates, err := p2p.joinUpdates(newHost)
+	if err != nil {
+		newHost.Close()
+		newQUICReuse.Close()
+		return err
 	}
 
-	stopper := func() error {
-		log.Debug("Stopping p2p server")
-		p2p.grpcServer.GracefulStop()
-		return p2p.host.Close()
+	p2p.hostMu.Lock()
+	oldHost := p2p.host
+	oldGRPCCancel := p2p.grpcCancel
+	oldUpdates := p2p.updates
+
+	// the ports can't be shared by the two hosts, so the listeners of the previous host are closed and its QUIC
+	// sockets are released before the new host listens. The connections that don't use QUIC stay open
+	err = closeListeners(oldHost)
+	if err == nil {
+		err = p2p.quicReuse.Close()
+	}
+	if err == nil {
+		err = listen(newHost, p2p.listenAddrs)
+	}
+	if err != nil {
+		if lerr := listen(oldHost, p2p.listenAddrs); lerr != nil {
+			log.Errorf("Failed to restore the listeners of the p2p host: %s", lerr.Error())
+		}
+		p2p.hostMu.Unlock()
+		newUpdates.close()
+		if cerr := newHost.Close(); cerr != nil {
+			log.Errorf("Failed to close new p2p host: %s", cerr.Error())
+		}
+		newQUICReuse.Close()
+		return fmt.Errorf("failed to move the listeners to the new p2p host: %w", err)
 	}
-	return stopper, nil
 
-}
+	p2p.host = newHost
+	p2p.quicReuse = newQUICReuse
+	p2p.grpcCancel = p2p.serveGRPC(newHost)
+	p2p.updates = newUpdates
+	p2p.hostMu.Unlock()
 
-// NewManager creates and returns a new p2p manager
-func NewManager(key *pcrypto.Key, appManager AppManager, initMode bool, externalDB ExternalDB) (*P2P, error) {
-	p2p := &P2P{
-		peers:       util.NewMap[string, *rpcPeer](),
-		appManager:  appManager,
-		newPeerChan: make(chan peer.AddrInfo),
-		initMode:    initMode,
+	p2p.serveFiles(newHost)
+	p2p.servePortForwards(newHost)
+	p2p.lanDiscovery.start(newHost)
 
-		externalDB: externalDB,
+	retiring := &retiringHost{host: oldHost, grpcCancel: oldGRPCCancel, updates: oldUpdates}
+	retiring.timer = time.AfterFunc(time.Until(retireAt), func() { p2p.retireHost(retiring) })
+	p2p.retiring.Set(oldHost.ID().String(), retiring)
+
+	log.Infof("Rotated p2p identity from '%s' to '%s'. The previous identity is retired at %s", oldHost.ID().String(), newHost.ID().String(), retireAt.String())
+	if p2p.peerConfigurator != nil {
+		return p2p.peerConfigurator.Refresh()
 	}
+	return nil
+}
 
+// retireHost closes a previous host, once the peers no longer accept its identity
+func (p2p *P2P) retireHost(retiring *retiringHost) {
+	log.Infof("Retiring previous p2p identity '%s'", retiring.host.ID().String())
+	p2p.retiring.Delete(retiring.host.ID().String())
+	retiring.grpcCancel()
+	retiring.updates.close()
+	err := retiring.host.Close()
+	if err != nil {
+		log.Errorf("Failed to close previous p2p host: %s", err.Error())
+	}
+}
+
+// createHost creates a libp2p host that uses the provided key as its identity. The host doesn't listen on any address
+// until listen is called. The returned QUIC connection manager owns the UDP sockets of the host, which are only
+// released when it's closed
+func (p2p *P2P) createHost(key *pcrypto.Key) (host.Host, *quicreuse.ConnManager, error) {
 	prvKey, err := crypto.UnmarshalEd25519PrivateKey(key.Private())
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	con, err := connmgr.NewConnManager(100, 400)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	transportOpts, _, err := transportOptions(p2p.transports)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	host, err := libp2p.New(
+	quicReuse, err := newQUICReuse(prvKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	opts := []libp2p.Option{
 		libp2p.Identity(prvKey),
-		libp2p.ListenAddrStrings(
-			fmt.Sprintf("/ip4/0.0.0.0/udp/%d/quic-v1", p2pPort),
-		),
+		// noise secures the tcp and websocket connections, while quic and webtransport use their own encryption
 		libp2p.Security(noise.ID, noise.New),
-		libp2p.Transport(quic.NewTransport),
 		libp2p.ConnectionManager(con),
-	)
+		// NAT traversal: port mapping, reachability detection, relayed connections and hole punching
+		libp2p.NATPortMap(),
+		libp2p.EnableNATService(),
+		libp2p.EnableRelay(),
+		libp2p.EnableAutoRelayWithPeerSource(p2p.relayPeerSource, autorelay.WithMinInterval(time.Minute)),
+		libp2p.EnableHolePunching(),
+		libp2p.NoListenAddrs,
+		libp2p.QUICReuse(func() (*quicreuse.ConnManager, error) { return quicReuse, nil }),
+	}
+	if p2p.relayService {
+		opts = append(opts, libp2p.EnableRelayService())
+	}
+	opts = append(opts, transportOpts...)
+
+	host, err := libp2p.New(opts...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to setup p2p host: %w", err)
+		quicReuse.Close()
+		return nil, nil, fmt.Errorf("failed to setup p2p host: %w", err)
 	}
 
-	p2p.host = host
 	nb := network.NotifyBundle{
 		ConnectedF:    p2p.newConnectionHandler,
 		DisconnectedF: p2p.closeConnectionHandler,
 	}
-	p2p.host.Network().Notify(&nb)
+	host.Network().Notify(&nb)
 
 	log.Debugf("Using host with ID '%s'", host.ID().String())
+	return host, quicReuse, nil
+}
+
+// NewManager creates and returns a new p2p manager. If relayService is enabled, the host acts as a relay for peers
+// that can't be reached directly. The key resolver is optional, and is used to follow the key rotations of the peers.
+// The capability manager is used to authorize the calls made by the peers. Known peers that are on the same LAN are
+// discovered using mDNS, and reached via their LAN addresses. The transports and listen addresses of the host are
+// configured using the p2p config
+func NewManager(key *pcrypto.Key, appManager AppManager, networkManager NetworkProvider, peerConfigurator PeerConfigurator, keyResolver KeyResolver, cm *capability.Manager, initMode bool, relayService bool, transports config.P2PConfig, externalDB ExternalDB) (*P2P, error) {
+	p2p := &P2P{
+		peers:             util.NewMap[string, *rpcPeer](),
+		aliases:           util.NewMap[string, string](),
+		endpoints:         util.NewMap[string, PeerEndpoint](),
+		retiring:          util.NewMap[string, *retiringHost](),
+		appManager:        appManager,
+		networkManager:    networkManager,
+		peerConfigurator:  peerConfigurator,
+		keyResolver:       keyResolver,
+		relayService:      relayService,
+		transports:        transports,
+		capabilityManager: cm,
+		newPeerChan:       make(chan peer.AddrInfo),
+		refreshChan:       make(chan struct{}, 1),
+		events:            &eventBus{subscribers: map[*eventSubscriber]bool{}},
+
+		externalDB: externalDB,
+	}
+	p2p.initMode.Store(initMode)
+	setMethodCaps(cm)
+	p2p.lanDiscovery = &lanDiscovery{p2p: p2p, addrs: map[peer.ID]string{}}
+	p2p.grpcServer = grpc.NewServer(
+		p2pgrpc.WithP2PCredentials(),
+		grpc.UnaryInterceptor(p2p.unaryAuthzInterceptor),
+		grpc.StreamInterceptor(p2p.streamAuthzInterceptor),
+	)
+
+	var err error
+	_, p2p.listenAddrs, err = transportOptions(transports)
+	if err != nil {
+		return nil, err
+	}
+	p2p.host, p2p.quicReuse, err = p2p.createHost(key)
+	if err != nil {
+		return nil, err
+	}
+
 	return p2p, nil
 }
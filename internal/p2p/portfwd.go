@@ -0,0 +1,219 @@
+package p2p
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	p2pproto "github.com/protosio/protos/internal/p2p/proto"
+	"google.golang.org/protobuf/encoding/protodelim"
+)
+
+const (
+	maxPortFwdMessageSize = 4096
+	portFwdDialTimeout    = 10 * time.Second
+)
+
+// PortForwardEvent describes a connection handled by a port forward. Err is set if the connection could not be
+// forwarded, or if it ended with an error
+type PortForwardEvent struct {
+	Client string // address of the local client
+	Closed bool
+	Err    error
+}
+
+// closeWrite signals the end of the data written to a connection, while still allowing it to be read
+func closeWrite(conn io.Closer) error {
+	if cw, ok := conn.(interface{ CloseWrite() error }); ok {
+		return cw.CloseWrite()
+	}
+	return conn.Close()
+}
+
+// proxy copies the traffic between a stream and a connection, until both directions are done. An error in either
+// direction closes both sides
+func proxy(stream network.Stream, streamReader io.Reader, conn net.Conn) error {
+	var wg sync.WaitGroup
+	errs := make(chan error, 2)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		_, err := io.Copy(conn, streamReader)
+		if err != nil {
+			errs <- err
+			stream.Reset()
+			conn.Close()
+			return
+		}
+		closeWrite(conn)
+	}()
+	go func() {
+		defer wg.Done()
+		_, err := io.Copy(stream, conn)
+		if err != nil {
+			errs <- err
+			stream.Reset()
+			conn.Close()
+			return
+		}
+		stream.CloseWrite()
+	}()
+	wg.Wait()
+	close(errs)
+
+	conn.Close()
+	stream.Close()
+	return <-errs
+}
+
+//
+// Server side of the port forwarding protocol
+//
+
+// servePortForwards proxies the connections forwarded by the devices of the user to the apps running on the local
+// instance, using the IPs the apps have on the local bridge
+func (p2p *P2P) servePortForwards(h host.Host) {
+	h.SetStreamHandler(protocol.ID(protosPortFwdProtocol), func(stream network.Stream) {
+		remotePeer := stream.Conn().RemotePeer()
+		if err := p2p.authorizeDeviceStream(remotePeer); err != nil {
+			log.Warnf("Rejecting port forward: %s", err.Error())
+			stream.Reset()
+			return
+		}
+
+		reader := bufio.NewReader(stream)
+		req := &p2pproto.PortForwardRequest{}
+		err := protodelim.UnmarshalOptions{MaxSize: maxPortFwdMessageSize}.UnmarshalFrom(reader, req)
+		if err != nil {
+			log.Errorf("Failed to read port forward request from '%s': %s", remotePeer.String(), err.Error())
+			stream.Reset()
+			return
+		}
+
+		conn, err := p2p.dialApp(req.AppName, req.Port)
+		if err != nil {
+			log.Errorf("Failed to forward connection from '%s': %s", remotePeer.String(), err.Error())
+			if _, writeErr := protodelim.MarshalTo(stream, &p2pproto.PortForwardResponse{Error: err.Error()}); writeErr != nil {
+				stream.Reset()
+				return
+			}
+			stream.Close()
+			return
+		}
+
+		_, err = protodelim.MarshalTo(stream, &p2pproto.PortForwardResponse{})
+		if err != nil {
+			conn.Close()
+			stream.Reset()
+			return
+		}
+
+		log.Debugf("Forwarding connection from '%s' to port %d of app '%s'", remotePeer.String(), req.Port, req.AppName)
+		err = proxy(stream, reader, conn)
+		if err != nil {
+			log.Debugf("Forwarded connection to app '%s' ended with error: %s", req.AppName, err.Error())
+		}
+	})
+}
+
+// dialApp connects to a port of a local app
+func (p2p *P2P) dialApp(appName string, port int32) (net.Conn, error) {
+	if port < 1 || port > 65535 {
+		return nil, fmt.Errorf("port %d is invalid", port)
+	}
+	ip, err := p2p.appManager.GetIP(appName)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(ip.String(), strconv.Itoa(int(port))), portFwdDialTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to port %d of app '%s': %w", port, appName, err)
+	}
+	return conn, nil
+}
+
+//
+// Client side of the port forwarding protocol
+//
+
+// openPortForward opens a stream that is forwarded to a port of an app
+func (p2p *P2P) openPortForward(ctx context.Context, instanceName string, appName string, port int) (network.Stream, io.Reader, error) {
+	client, err := p2p.GetClient(instanceName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !p2p.peerSupports(client.peer, FeaturePortForward) {
+		return nil, nil, fmt.Errorf("instance '%s' doesn't support port forwarding and needs to be upgraded", instanceName)
+	}
+	stream, err := p2p.getHost().NewStream(ctx, client.peer, protocol.ID(protosPortFwdProtocol))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open port forwarding stream to instance '%s': %w", instanceName, err)
+	}
+
+	_, err = protodelim.MarshalTo(stream, &p2pproto.PortForwardRequest{AppName: appName, Port: int32(port)})
+	if err != nil {
+		stream.Reset()
+		return nil, nil, fmt.Errorf("failed to send port forward request to instance '%s': %w", instanceName, err)
+	}
+
+	reader := bufio.NewReader(stream)
+	resp := &p2pproto.PortForwardResponse{}
+	err = protodelim.UnmarshalOptions{MaxSize: maxPortFwdMessageSize}.UnmarshalFrom(reader, resp)
+	if err != nil {
+		stream.Reset()
+		return nil, nil, fmt.Errorf("failed to read port forward response from instance '%s': %w", instanceName, err)
+	}
+	if resp.Error != "" {
+		stream.Close()
+		return nil, nil, errors.New(resp.Error)
+	}
+	return stream, reader, nil
+}
+
+// ForwardPort forwards the connections accepted by a local listener to a port of an app, via the instance the app
+// runs on. Every connection uses its own stream, and the traffic doesn't depend on the WireGuard network. It blocks
+// until the context is cancelled, and the notify function is called when connections are opened and closed
+func (p2p *P2P) ForwardPort(ctx context.Context, listener net.Listener, instanceName string, appName string, port int, notify func(PortForwardEvent)) error {
+	stop := context.AfterFunc(ctx, func() { listener.Close() })
+	defer stop()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to accept connection: %w", err)
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client := conn.RemoteAddr().String()
+			stream, reader, err := p2p.openPortForward(ctx, instanceName, appName, port)
+			if err != nil {
+				conn.Close()
+				notify(PortForwardEvent{Client: client, Closed: true, Err: err})
+				return
+			}
+			notify(PortForwardEvent{Client: client})
+
+			// connections are closed when the port forward stops
+			stopConn := context.AfterFunc(ctx, func() { stream.Reset() })
+			err = proxy(stream, reader, conn)
+			stopConn()
+			notify(PortForwardEvent{Client: client, Closed: true, Err: err})
+		}()
+	}
+}
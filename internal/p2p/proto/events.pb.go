@@ -0,0 +1,564 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: internal/p2p/proto/events.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DBHeadEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Head string `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+}
+
+func (x *DBHeadEvent) Reset() {
+	*x = DBHeadEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_p2p_proto_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DBHeadEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBHeadEvent) ProtoMessage() {}
+
+func (x *DBHeadEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_p2p_proto_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBHeadEvent.ProtoReflect.Descriptor instead.
+func (*DBHeadEvent) Descriptor() ([]byte, []int) {
+	return file_internal_p2p_proto_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DBHeadEvent) GetHead() string {
+	if x != nil {
+		return x.Head
+	}
+	return ""
+}
+
+type AppStatusEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppId        string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	AppName      string `protobuf:"bytes,2,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	InstanceName string `protobuf:"bytes,3,opt,name=instance_name,json=instanceName,proto3" json:"instance_name,omitempty"`
+	Status       string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *AppStatusEvent) Reset() {
+	*x = AppStatusEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_p2p_proto_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppStatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppStatusEvent) ProtoMessage() {}
+
+func (x *AppStatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_p2p_proto_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppStatusEvent.ProtoReflect.Descriptor instead.
+func (*AppStatusEvent) Descriptor() ([]byte, []int) {
+	return file_internal_p2p_proto_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AppStatusEvent) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *AppStatusEvent) GetAppName() string {
+	if x != nil {
+		return x.AppName
+	}
+	return ""
+}
+
+func (x *AppStatusEvent) GetInstanceName() string {
+	if x != nil {
+		return x.InstanceName
+	}
+	return ""
+}
+
+func (x *AppStatusEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type InstanceStatusEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceName string `protobuf:"bytes,1,opt,name=instance_name,json=instanceName,proto3" json:"instance_name,omitempty"`
+	Status       string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *InstanceStatusEvent) Reset() {
+	*x = InstanceStatusEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_p2p_proto_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceStatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceStatusEvent) ProtoMessage() {}
+
+func (x *InstanceStatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_p2p_proto_events_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceStatusEvent.ProtoReflect.Descriptor instead.
+func (*InstanceStatusEvent) Descriptor() ([]byte, []int) {
+	return file_internal_p2p_proto_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InstanceStatusEvent) GetInstanceName() string {
+	if x != nil {
+		return x.InstanceName
+	}
+	return ""
+}
+
+func (x *InstanceStatusEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type PeerEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerId string `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *PeerEvent) Reset() {
+	*x = PeerEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_p2p_proto_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerEvent) ProtoMessage() {}
+
+func (x *PeerEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_p2p_proto_events_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerEvent.ProtoReflect.Descriptor instead.
+func (*PeerEvent) Descriptor() ([]byte, []int) {
+	return file_internal_p2p_proto_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PeerEvent) GetPeerId() string {
+	if x != nil {
+		return x.PeerId
+	}
+	return ""
+}
+
+func (x *PeerEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp int64  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // unix nanoseconds
+	Source    string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`        // peer ID of the machine that generated the event
+	// Types that are assignable to Payload:
+	//	*Event_DbHead
+	//	*Event_AppStatus
+	//	*Event_InstanceStatus
+	//	*Event_PeerJoin
+	//	*Event_PeerLeave
+	Payload isEvent_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_p2p_proto_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_p2p_proto_events_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_internal_p2p_proto_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Event) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Event) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (m *Event) GetPayload() isEvent_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *Event) GetDbHead() *DBHeadEvent {
+	if x, ok := x.GetPayload().(*Event_DbHead); ok {
+		return x.DbHead
+	}
+	return nil
+}
+
+func (x *Event) GetAppStatus() *AppStatusEvent {
+	if x, ok := x.GetPayload().(*Event_AppStatus); ok {
+		return x.AppStatus
+	}
+	return nil
+}
+
+func (x *Event) GetInstanceStatus() *InstanceStatusEvent {
+	if x, ok := x.GetPayload().(*Event_InstanceStatus); ok {
+		return x.InstanceStatus
+	}
+	return nil
+}
+
+func (x *Event) GetPeerJoin() *PeerEvent {
+	if x, ok := x.GetPayload().(*Event_PeerJoin); ok {
+		return x.PeerJoin
+	}
+	return nil
+}
+
+func (x *Event) GetPeerLeave() *PeerEvent {
+	if x, ok := x.GetPayload().(*Event_PeerLeave); ok {
+		return x.PeerLeave
+	}
+	return nil
+}
+
+type isEvent_Payload interface {
+	isEvent_Payload()
+}
+
+type Event_DbHead struct {
+	DbHead *DBHeadEvent `protobuf:"bytes,3,opt,name=db_head,json=dbHead,proto3,oneof"`
+}
+
+type Event_AppStatus struct {
+	AppStatus *AppStatusEvent `protobuf:"bytes,4,opt,name=app_status,json=appStatus,proto3,oneof"`
+}
+
+type Event_InstanceStatus struct {
+	InstanceStatus *InstanceStatusEvent `protobuf:"bytes,5,opt,name=instance_status,json=instanceStatus,proto3,oneof"`
+}
+
+type Event_PeerJoin struct {
+	PeerJoin *PeerEvent `protobuf:"bytes,6,opt,name=peer_join,json=peerJoin,proto3,oneof"`
+}
+
+type Event_PeerLeave struct {
+	PeerLeave *PeerEvent `protobuf:"bytes,7,opt,name=peer_leave,json=peerLeave,proto3,oneof"`
+}
+
+func (*Event_DbHead) isEvent_Payload() {}
+
+func (*Event_AppStatus) isEvent_Payload() {}
+
+func (*Event_InstanceStatus) isEvent_Payload() {}
+
+func (*Event_PeerJoin) isEvent_Payload() {}
+
+func (*Event_PeerLeave) isEvent_Payload() {}
+
+var File_internal_p2p_proto_events_proto protoreflect.FileDescriptor
+
+var file_internal_p2p_proto_events_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x32, 0x70, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x21, 0x0a, 0x0b, 0x44, 0x42, 0x48, 0x65,
+	0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x65, 0x61, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x65, 0x61, 0x64, 0x22, 0x7f, 0x0a, 0x0e, 0x41,
+	0x70, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x15, 0x0a,
+	0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x70, 0x70, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x52, 0x0a, 0x13,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x38, 0x0a, 0x09, 0x50, 0x65, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x65, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xda, 0x02, 0x0a, 0x05, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x64, 0x62,
+	0x5f, 0x68, 0x65, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x42, 0x48, 0x65, 0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48,
+	0x00, 0x52, 0x06, 0x64, 0x62, 0x48, 0x65, 0x61, 0x64, 0x12, 0x36, 0x0a, 0x0a, 0x61, 0x70, 0x70,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x09, 0x61, 0x70, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x45, 0x0a, 0x0f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72,
+	0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52,
+	0x08, 0x70, 0x65, 0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x31, 0x0a, 0x0a, 0x70, 0x65, 0x65,
+	0x72, 0x5f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48,
+	0x00, 0x52, 0x09, 0x70, 0x65, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x42, 0x09, 0x0a, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x09, 0x5a, 0x07, 0x2e, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_internal_p2p_proto_events_proto_rawDescOnce sync.Once
+	file_internal_p2p_proto_events_proto_rawDescData = file_internal_p2p_proto_events_proto_rawDesc
+)
+
+func file_internal_p2p_proto_events_proto_rawDescGZIP() []byte {
+	file_internal_p2p_proto_events_proto_rawDescOnce.Do(func() {
+		file_internal_p2p_proto_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_p2p_proto_events_proto_rawDescData)
+	})
+	return file_internal_p2p_proto_events_proto_rawDescData
+}
+
+var file_internal_p2p_proto_events_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_internal_p2p_proto_events_proto_goTypes = []interface{}{
+	(*DBHeadEvent)(nil),         // 0: proto.DBHeadEvent
+	(*AppStatusEvent)(nil),      // 1: proto.AppStatusEvent
+	(*InstanceStatusEvent)(nil), // 2: proto.InstanceStatusEvent
+	(*PeerEvent)(nil),           // 3: proto.PeerEvent
+	(*Event)(nil),               // 4: proto.Event
+}
+var file_internal_p2p_proto_events_proto_depIdxs = []int32{
+	0, // 0: proto.Event.db_head:type_name -> proto.DBHeadEvent
+	1, // 1: proto.Event.app_status:type_name -> proto.AppStatusEvent
+	2, // 2: proto.Event.instance_status:type_name -> proto.InstanceStatusEvent
+	3, // 3: proto.Event.peer_join:type_name -> proto.PeerEvent
+	3, // 4: proto.Event.peer_leave:type_name -> proto.PeerEvent
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_internal_p2p_proto_events_proto_init() }
+func file_internal_p2p_proto_events_proto_init() {
+	if File_internal_p2p_proto_events_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_internal_p2p_proto_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DBHeadEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_p2p_proto_events_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppStatusEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_p2p_proto_events_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceStatusEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_p2p_proto_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_p2p_proto_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_internal_p2p_proto_events_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*Event_DbHead)(nil),
+		(*Event_AppStatus)(nil),
+		(*Event_InstanceStatus)(nil),
+		(*Event_PeerJoin)(nil),
+		(*Event_PeerLeave)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_internal_p2p_proto_events_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_internal_p2p_proto_events_proto_goTypes,
+		DependencyIndexes: file_internal_p2p_proto_events_proto_depIdxs,
+		MessageInfos:      file_internal_p2p_proto_events_proto_msgTypes,
+	}.Build()
+	File_internal_p2p_proto_events_proto = out.File
+	file_internal_p2p_proto_events_proto_rawDesc = nil
+	file_internal_p2p_proto_events_proto_goTypes = nil
+	file_internal_p2p_proto_events_proto_depIdxs = nil
+}
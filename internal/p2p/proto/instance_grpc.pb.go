@@ -19,9 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Instance_Init_FullMethodName     = "/proto.Instance/Init"
-	Instance_GetPeers_FullMethodName = "/proto.Instance/GetPeers"
-	Instance_GetLogs_FullMethodName  = "/proto.Instance/GetLogs"
+	Instance_Init_FullMethodName             = "/proto.Instance/Init"
+	Instance_GetPeers_FullMethodName         = "/proto.Instance/GetPeers"
+	Instance_GetLogs_FullMethodName          = "/proto.Instance/GetLogs"
+	Instance_GetNetworkStatus_FullMethodName = "/proto.Instance/GetNetworkStatus"
 )
 
 // InstanceClient is the client API for Instance service.
@@ -31,6 +32,7 @@ type InstanceClient interface {
 	Init(ctx context.Context, in *InitRequest, opts ...grpc.CallOption) (*InitResponse, error)
 	GetPeers(ctx context.Context, in *GetPeersRequest, opts ...grpc.CallOption) (*GetPeersResponse, error)
 	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error)
+	GetNetworkStatus(ctx context.Context, in *GetNetworkStatusRequest, opts ...grpc.CallOption) (*GetNetworkStatusResponse, error)
 }
 
 type instanceClient struct {
@@ -68,6 +70,15 @@ func (c *instanceClient) GetLogs(ctx context.Context, in *GetLogsRequest, opts .
 	return out, nil
 }
 
+func (c *instanceClient) GetNetworkStatus(ctx context.Context, in *GetNetworkStatusRequest, opts ...grpc.CallOption) (*GetNetworkStatusResponse, error) {
+	out := new(GetNetworkStatusResponse)
+	err := c.cc.Invoke(ctx, Instance_GetNetworkStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InstanceServer is the server API for Instance service.
 // All implementations should embed UnimplementedInstanceServer
 // for forward compatibility
@@ -75,6 +86,7 @@ type InstanceServer interface {
 	Init(context.Context, *InitRequest) (*InitResponse, error)
 	GetPeers(context.Context, *GetPeersRequest) (*GetPeersResponse, error)
 	GetLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error)
+	GetNetworkStatus(context.Context, *GetNetworkStatusRequest) (*GetNetworkStatusResponse, error)
 }
 
 // UnimplementedInstanceServer should be embedded to have forward compatible implementations.
@@ -90,6 +102,9 @@ func (UnimplementedInstanceServer) GetPeers(context.Context, *GetPeersRequest) (
 func (UnimplementedInstanceServer) GetLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLogs not implemented")
 }
+func (UnimplementedInstanceServer) GetNetworkStatus(context.Context, *GetNetworkStatusRequest) (*GetNetworkStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkStatus not implemented")
+}
 
 // UnsafeInstanceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to InstanceServer will
@@ -156,6 +171,24 @@ func _Instance_GetLogs_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Instance_GetNetworkStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetworkStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServer).GetNetworkStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Instance_GetNetworkStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServer).GetNetworkStatus(ctx, req.(*GetNetworkStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Instance_ServiceDesc is the grpc.ServiceDesc for Instance service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -175,6 +208,10 @@ var Instance_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLogs",
 			Handler:    _Instance_GetLogs_Handler,
 		},
+		{
+			MethodName: "GetNetworkStatus",
+			Handler:    _Instance_GetNetworkStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/p2p/proto/instance.proto",
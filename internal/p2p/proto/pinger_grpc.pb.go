@@ -19,7 +19,8 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Pinger_Ping_FullMethodName = "/proto.Pinger/Ping"
+	Pinger_Ping_FullMethodName      = "/proto.Pinger/Ping"
+	Pinger_Handshake_FullMethodName = "/proto.Pinger/Handshake"
 )
 
 // PingerClient is the client API for Pinger service.
@@ -27,6 +28,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type PingerClient interface {
 	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+	Handshake(ctx context.Context, in *HandshakeRequest, opts ...grpc.CallOption) (*HandshakeResponse, error)
 }
 
 type pingerClient struct {
@@ -46,11 +48,21 @@ func (c *pingerClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.C
 	return out, nil
 }
 
+func (c *pingerClient) Handshake(ctx context.Context, in *HandshakeRequest, opts ...grpc.CallOption) (*HandshakeResponse, error) {
+	out := new(HandshakeResponse)
+	err := c.cc.Invoke(ctx, Pinger_Handshake_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PingerServer is the server API for Pinger service.
 // All implementations should embed UnimplementedPingerServer
 // for forward compatibility
 type PingerServer interface {
 	Ping(context.Context, *PingRequest) (*PingResponse, error)
+	Handshake(context.Context, *HandshakeRequest) (*HandshakeResponse, error)
 }
 
 // UnimplementedPingerServer should be embedded to have forward compatible implementations.
@@ -60,6 +72,9 @@ type UnimplementedPingerServer struct {
 func (UnimplementedPingerServer) Ping(context.Context, *PingRequest) (*PingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
 }
+func (UnimplementedPingerServer) Handshake(context.Context, *HandshakeRequest) (*HandshakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Handshake not implemented")
+}
 
 // UnsafePingerServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to PingerServer will
@@ -90,6 +105,24 @@ func _Pinger_Ping_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Pinger_Handshake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandshakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PingerServer).Handshake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pinger_Handshake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PingerServer).Handshake(ctx, req.(*HandshakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Pinger_ServiceDesc is the grpc.ServiceDesc for Pinger service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -101,6 +134,10 @@ var Pinger_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Ping",
 			Handler:    _Pinger_Ping_Handler,
 		},
+		{
+			MethodName: "Handshake",
+			Handler:    _Pinger_Handshake_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/p2p/proto/pinger.proto",
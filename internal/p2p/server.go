@@ -14,6 +14,8 @@ import (
 	"github.com/nustiueudinastea/doltswarm"
 	"github.com/protosio/protos/internal/p2p/proto"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ proto.PingerServer = (*Server)(nil)
@@ -31,6 +33,7 @@ type ExternalDB interface {
 type MetaConfigurator interface {
 	SetNetwork(network net.IPNet) net.IP
 	SetInstanceName(name string)
+	GetVersion() string
 }
 
 type initMachine struct {
@@ -48,6 +51,21 @@ func (im *initMachine) GetPublicIP() string {
 func (im *initMachine) GetName() string {
 	return im.name
 }
+func (im *initMachine) GetMachineID() string {
+	return im.name
+}
+func (im *initMachine) GetMultiaddrs() []string {
+	return nil
+}
+
+// GetType returns the device type for the machine that initialised the instance. Placeholder machines created for
+// peers that are not known yet don't have a type
+func (im *initMachine) GetType() string {
+	if im.name == initMachineName {
+		return MachineTypeDevice
+	}
+	return ""
+}
 
 type Server struct {
 	DB               ExternalDB
@@ -110,30 +128,25 @@ func (s *Server) GetLogs(context.Context, *proto.GetLogsRequest) (*proto.GetLogs
 	return &proto.GetLogsResponse{Logs: encodedLogs}, nil
 }
 
-// HandlerGetInstancePeers retrieves the peers for the local instance
+// GetPeers retrieves the connection state of the peers of the local instance
 func (s *Server) GetPeers(context.Context, *proto.GetPeersRequest) (*proto.GetPeersResponse, error) {
+	return &proto.GetPeersResponse{PeerStates: s.p2p.GetPeerStates()}, nil
+}
 
-	peers := map[string]string{}
-
-	for id, rpcpeer := range s.p2p.peers.Snapshot() {
-		client := rpcpeer.GetClient()
-		machine := rpcpeer.GetMachine()
-		peerName := fmt.Sprintf("unknown(%s)", id)
-		peerStatus := "disconnected"
-		if machine != nil {
-			peerName = fmt.Sprintf("%s(%s)", machine.GetName(), id)
-		}
-		if client != nil {
-			peerStatus = "connected"
-		}
-		peers[peerName] = peerStatus
+// GetNetworkStatus retrieves the status of the network connections to all the peers of the local instance
+func (s *Server) GetNetworkStatus(ctx context.Context, req *proto.GetNetworkStatusRequest) (*proto.GetNetworkStatusResponse, error) {
+	peers, err := s.p2p.GetNetworkStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve network status: %w", err)
 	}
-
-	return &proto.GetPeersResponse{Peers: peers}, nil
+	return &proto.GetNetworkStatusResponse{Peers: peers}, nil
 }
 
 // HandlerInit does the initialisation on the server side
 func (s *Server) Init(ctx context.Context, req *proto.InitRequest) (*proto.InitResponse, error) {
+	if !s.p2p.initMode.Load() {
+		return nil, status.Error(codes.FailedPrecondition, "instance is already initialised")
+	}
 
 	validate := validator.New()
 	err := validate.Struct(req)
@@ -151,16 +164,20 @@ func (s *Server) Init(ctx context.Context, req *proto.InitRequest) (*proto.InitR
 		publicKey: req.OriginDevicePublicKey,
 	}
 
-	s.p2p.initMode = false
+	// only one of the concurrent init calls leaves the init mode, the others are refused
+	if !s.p2p.initMode.CompareAndSwap(true, false) {
+		return nil, status.Error(codes.FailedPrecondition, "instance is already initialised")
+	}
 	_, err = s.p2p.AddPeer(im)
 	if err != nil {
+		s.p2p.initMode.Store(true)
 		return nil, fmt.Errorf("failed to add init device as rpc client: %w", err)
 	}
 
 	s.metaConfigurator.SetInstanceName(req.InstanceName)
 	ipNet := s.metaConfigurator.SetNetwork(*network)
 
-	return &proto.InitResponse{InstanceIp: ipNet.String(), Architecture: runtime.GOARCH}, nil
+	return &proto.InitResponse{InstanceIp: ipNet.String(), Architecture: runtime.GOARCH, ListenAddrs: s.p2p.ListenAddrs()}, nil
 }
 
 func (s *Server) GetAppLogs(ctx context.Context, req *proto.GetAppLogsRequest) (*proto.GetAppLogsResponse, error) {
@@ -0,0 +1,114 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	p2pproto "github.com/protosio/protos/internal/p2p/proto"
+	"github.com/protosio/protos/internal/pcrypto"
+)
+
+const pingTimeout = 5 * time.Second
+
+// transportName returns a human readable name for the transport used by a connection
+func transportName(conn network.Conn) string {
+	addr := conn.RemoteMultiaddr()
+	if _, err := addr.ValueForProtocol(multiaddr.P_CIRCUIT); err == nil {
+		return "relay"
+	}
+
+	for _, proto := range []int{multiaddr.P_WEBTRANSPORT, multiaddr.P_WS, multiaddr.P_QUIC_V1, multiaddr.P_QUIC, multiaddr.P_TCP, multiaddr.P_UDP} {
+		if _, err := addr.ValueForProtocol(proto); err == nil {
+			return multiaddr.ProtocolWithCode(proto).Name
+		}
+	}
+	return "unknown"
+}
+
+// PingPeer pings a peer using the Pinger service, and returns the round trip time
+func (p2p *P2P) PingPeer(ctx context.Context, name string) (time.Duration, error) {
+	client, err := p2p.GetClient(name)
+	if err != nil {
+		return 0, err
+	}
+	return pingClient(ctx, client)
+}
+
+func pingClient(ctx context.Context, client *Client) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, pingTimeout)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.Ping(ctx, &p2pproto.PingRequest{Ping: "ping"})
+	if err != nil {
+		return 0, fmt.Errorf("failed to ping peer '%s': %w", client.peer.String(), err)
+	}
+	return time.Since(start), nil
+}
+
+// GetNetworkStatus returns the status of the libp2p and WireGuard connections for every known peer
+func (p2p *P2P) GetNetworkStatus(ctx context.Context) ([]*p2pproto.PeerNetworkStatus, error) {
+	wgStats := map[string]*p2pproto.WireguardStatus{}
+	if p2p.networkManager != nil {
+		stats, err := p2p.networkManager.GetPeerStats()
+		if err != nil {
+			log.Warnf("Failed to retrieve WireGuard statistics: %s", err.Error())
+		} else {
+			wgStats = stats
+		}
+	}
+
+	var wg sync.WaitGroup
+	peersStatus := []*p2pproto.PeerNetworkStatus{}
+	for id, rpcpeer := range p2p.peers.Snapshot() {
+		peerStatus := &p2pproto.PeerNetworkStatus{Name: "unknown", Id: id}
+
+		machine := rpcpeer.GetMachine()
+		if machine != nil {
+			peerStatus.Name = machine.GetName()
+			if wgKey, err := pcrypto.ConvertPublicEd25519ToCurve25519(machine.GetPublicKey()); err == nil {
+				peerStatus.Wireguard = wgStats[wgKey.String()]
+			}
+		}
+
+		peerID, err := peer.Decode(id)
+		if err == nil {
+			conns := p2p.getHost().Network().ConnsToPeer(peerID)
+			peerStatus.Connected = len(conns) > 0
+			for _, conn := range conns {
+				peerStatus.Transport = transportName(conn)
+				peerStatus.Relayed = conn.Stat().Transient
+				if !peerStatus.Relayed {
+					break
+				}
+			}
+		}
+
+		// the peers are pinged concurrently, so that unreachable peers don't delay the response by the ping timeout each
+		client := rpcpeer.GetClient()
+		if client != nil {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				rtt, err := pingClient(ctx, client)
+				if err != nil {
+					peerStatus.PingError = err.Error()
+				} else {
+					peerStatus.Rtt = rtt.Microseconds()
+				}
+			}()
+		} else {
+			peerStatus.PingError = "no RPC client for peer"
+		}
+
+		peersStatus = append(peersStatus, peerStatus)
+	}
+	wg.Wait()
+
+	return peersStatus, nil
+}
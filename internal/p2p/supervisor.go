@@ -0,0 +1,232 @@
+package p2p
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	p2pproto "github.com/protosio/protos/internal/p2p/proto"
+)
+
+// Connection states of a peer
+const (
+	PeerStateDisconnected = "disconnected"
+	PeerStateConnecting   = "connecting"
+	PeerStateConnected    = "connected"
+	PeerStateBackoff      = "backoff"
+)
+
+const (
+	supervisorInterval = time.Second
+	qualityInterval    = 30 * time.Second
+	dialTimeout        = 30 * time.Second
+	minReconnectDelay  = time.Second
+	maxReconnectDelay  = 5 * time.Minute
+	// weight of the latest ping in the average round trip time
+	rttWeight = 0.2
+)
+
+// peerStatus tracks the connection state and quality of a peer
+type peerStatus struct {
+	state          string
+	attempts       int
+	nextAttempt    time.Time
+	lastError      string
+	connectedSince time.Time
+	disconnects    int
+	rtt            time.Duration
+	pingFailures   int
+}
+
+// reconnectDelay returns the exponential backoff delay for a number of failed attempts, with some jitter so that
+// peers don't reconnect in lockstep
+func reconnectDelay(attempts int) time.Duration {
+	delay := maxReconnectDelay
+	if attempts < 20 {
+		delay = minReconnectDelay << uint(attempts)
+	}
+	if delay > maxReconnectDelay {
+		delay = maxReconnectDelay
+	}
+	return delay + time.Duration(rand.Int63n(int64(delay)/10+1))
+}
+
+func (peer *rpcPeer) getStatus() peerStatus {
+	peer.mu.Lock()
+	defer peer.mu.Unlock()
+	return peer.status
+}
+
+func (peer *rpcPeer) markConnecting() {
+	peer.mu.Lock()
+	defer peer.mu.Unlock()
+	peer.status.state = PeerStateConnecting
+}
+
+func (peer *rpcPeer) markConnected() {
+	peer.mu.Lock()
+	defer peer.mu.Unlock()
+	if peer.status.state == PeerStateConnected {
+		return
+	}
+	peer.status.state = PeerStateConnected
+	peer.status.attempts = 0
+	peer.status.lastError = ""
+	peer.status.connectedSince = time.Now()
+}
+
+// markDisconnected records a lost connection. The first reconnection attempt is made right away
+func (peer *rpcPeer) markDisconnected() {
+	peer.mu.Lock()
+	defer peer.mu.Unlock()
+	if peer.status.state == PeerStateConnected {
+		peer.status.disconnects++
+	}
+	peer.status.state = PeerStateDisconnected
+	peer.status.connectedSince = time.Time{}
+	peer.status.nextAttempt = time.Now()
+}
+
+func (peer *rpcPeer) markDialFailed(err error) {
+	peer.mu.Lock()
+	defer peer.mu.Unlock()
+	peer.status.state = PeerStateBackoff
+	peer.status.lastError = err.Error()
+	peer.status.nextAttempt = time.Now().Add(reconnectDelay(peer.status.attempts))
+	peer.status.attempts++
+}
+
+func (peer *rpcPeer) recordPing(rtt time.Duration, err error) {
+	peer.mu.Lock()
+	defer peer.mu.Unlock()
+	if err != nil {
+		peer.status.pingFailures++
+		peer.status.lastError = err.Error()
+		return
+	}
+	if peer.status.rtt == 0 {
+		peer.status.rtt = rtt
+	} else {
+		peer.status.rtt = time.Duration(rttWeight*float64(rtt) + (1-rttWeight)*float64(peer.status.rtt))
+	}
+}
+
+//
+// Methods for supervising the peer connections
+//
+
+// peerSupervisor keeps the configured peers connected. Disconnected peers are redialed using exponential backoff,
+// and the connected ones are pinged periodically to track the quality of the connection. It runs until the context is
+// cancelled
+func (p2p *P2P) peerSupervisor(ctx context.Context) {
+	ticker := time.NewTicker(supervisorInterval)
+	defer ticker.Stop()
+
+	lastQualityCheck := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		h := p2p.getHost()
+		checkQuality := time.Since(lastQualityCheck) >= qualityInterval
+		if checkQuality {
+			lastQualityCheck = time.Now()
+		}
+
+		for id, rpcpeer := range p2p.peers.Snapshot() {
+			// aliases are only used by the peers to connect to us while they rotate their key
+			if _, isAlias := p2p.aliases.Get(id); isAlias {
+				continue
+			}
+			peerID, err := peer.Decode(id)
+			if err != nil {
+				continue
+			}
+
+			if h.Network().Connectedness(peerID) == network.Connected {
+				rpcpeer.markConnected()
+				if checkQuality {
+					go p2p.checkQuality(rpcpeer)
+				}
+				continue
+			}
+
+			status := rpcpeer.getStatus()
+			if status.state == PeerStateConnecting || time.Now().Before(status.nextAttempt) {
+				continue
+			}
+			rpcpeer.markConnecting()
+			go p2p.reconnect(ctx, peerID, rpcpeer)
+		}
+	}
+}
+
+// reconnect dials a peer that is not connected
+func (p2p *P2P) reconnect(ctx context.Context, peerID peer.ID, rpcpeer *rpcPeer) {
+	machine := rpcpeer.GetMachine()
+	if machine == nil {
+		return
+	}
+	peerInfo := p2p.peerAddrInfo(peerID, machine)
+
+	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+	err := p2p.getHost().Connect(ctx, peerInfo)
+	if err != nil {
+		rpcpeer.markDialFailed(err)
+		status := rpcpeer.getStatus()
+		log.Debugf("Failed to reconnect to peer '%s'(%s), retrying in %s: %s", machine.GetName(), peerID.String(), time.Until(status.nextAttempt).Round(time.Second), err.Error())
+		return
+	}
+	log.Infof("Reconnected to peer '%s'(%s)", machine.GetName(), peerID.String())
+	rpcpeer.markConnected()
+}
+
+// checkQuality pings a connected peer and records the round trip time
+func (p2p *P2P) checkQuality(rpcpeer *rpcPeer) {
+	client := rpcpeer.GetClient()
+	if client == nil {
+		return
+	}
+	rtt, err := pingClient(context.Background(), client)
+	rpcpeer.recordPing(rtt, err)
+}
+
+// GetPeerStates returns the connection state and quality of all the configured peers
+func (p2p *P2P) GetPeerStates() []*p2pproto.PeerState {
+	states := []*p2pproto.PeerState{}
+	for id, rpcpeer := range p2p.peers.Snapshot() {
+		state := &p2pproto.PeerState{Id: id, Name: "unknown"}
+		if machine := rpcpeer.GetMachine(); machine != nil {
+			state.Name = machine.GetName()
+			state.Type = machine.GetType()
+		}
+		if _, isAlias := p2p.aliases.Get(id); isAlias {
+			state.Alias = true
+		}
+
+		status := rpcpeer.getStatus()
+		state.State = status.state
+		if state.State == "" {
+			state.State = PeerStateDisconnected
+		}
+		if !status.connectedSince.IsZero() {
+			state.ConnectedSince = status.connectedSince.Unix()
+		}
+		if status.state == PeerStateBackoff {
+			state.NextAttempt = status.nextAttempt.Unix()
+		}
+		state.ReconnectAttempts = int32(status.attempts)
+		state.LastError = status.lastError
+		state.Disconnects = int32(status.disconnects)
+		state.Rtt = status.rtt.Microseconds()
+		state.PingFailures = int32(status.pingFailures)
+		states = append(states, state)
+	}
+	return states
+}
@@ -0,0 +1,172 @@
+package p2p
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/libp2p/go-libp2p"
+	libp2pconfig "github.com/libp2p/go-libp2p/config"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/host"
+	quic "github.com/libp2p/go-libp2p/p2p/transport/quic"
+	"github.com/libp2p/go-libp2p/p2p/transport/quicreuse"
+	"github.com/libp2p/go-libp2p/p2p/transport/tcp"
+	"github.com/libp2p/go-libp2p/p2p/transport/websocket"
+	webtransport "github.com/libp2p/go-libp2p/p2p/transport/webtransport"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/protosio/protos/internal/config"
+)
+
+// Transports that can be used for the p2p connections
+const (
+	TransportTCP          = "tcp"
+	TransportQUIC         = "quic"
+	TransportWebSocket    = "ws"
+	TransportWebTransport = "webtransport"
+)
+
+// transportOptions returns the libp2p options that enable the configured transports, and the addresses they listen on.
+// The host doesn't listen on them when it's created, see listen
+func transportOptions(cfg config.P2PConfig) ([]libp2p.Option, []multiaddr.Multiaddr, error) {
+	if len(cfg.Transports) == 0 {
+		return nil, nil, fmt.Errorf("no p2p transports configured")
+	}
+
+	opts := []libp2p.Option{}
+	listenAddrs := []string{}
+	for _, transport := range cfg.Transports {
+		switch transport {
+		case TransportTCP:
+			opts = append(opts, libp2p.Transport(tcp.NewTCPTransport))
+			listenAddrs = append(listenAddrs, fmt.Sprintf("/ip4/0.0.0.0/tcp/%d", cfg.TCPPort))
+		case TransportQUIC:
+			opts = append(opts, libp2p.Transport(quic.NewTransport))
+			listenAddrs = append(listenAddrs, fmt.Sprintf("/ip4/0.0.0.0/udp/%d/quic-v1", cfg.UDPPort))
+		case TransportWebSocket:
+			opts = append(opts, libp2p.Transport(websocket.New))
+			listenAddrs = append(listenAddrs, fmt.Sprintf("/ip4/0.0.0.0/tcp/%d/ws", cfg.WebSocketPort))
+		case TransportWebTransport:
+			opts = append(opts, libp2p.Transport(webtransport.New))
+			listenAddrs = append(listenAddrs, fmt.Sprintf("/ip4/0.0.0.0/udp/%d/quic-v1/webtransport", cfg.UDPPort))
+		default:
+			return nil, nil, fmt.Errorf("unknown p2p transport '%s'", transport)
+		}
+	}
+
+	if len(cfg.ListenAddrs) > 0 {
+		listenAddrs = cfg.ListenAddrs
+	}
+
+	addrs := []multiaddr.Multiaddr{}
+	for _, listenAddr := range listenAddrs {
+		addr, err := multiaddr.NewMultiaddr(listenAddr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid p2p listen address '%s': %w", listenAddr, err)
+		}
+		addrs = append(addrs, addr)
+	}
+	return opts, addrs, nil
+}
+
+// listen starts listening on the provided addresses. These are stored in the db and used by the peers to reach the
+// local machine, so it fails if any of them can't be used
+func listen(h host.Host, addrs []multiaddr.Multiaddr) error {
+	for _, addr := range addrs {
+		err := h.Network().Listen(addr)
+		if err != nil {
+			if cerr := closeListeners(h); cerr != nil {
+				log.Errorf("Failed to close p2p listeners: %s", cerr.Error())
+			}
+			return fmt.Errorf("failed to listen on '%s': %w", addr.String(), err)
+		}
+	}
+	return nil
+}
+
+// closeListeners stops listening on all the addresses of a host, without closing its connections
+func closeListeners(h host.Host) error {
+	swarm, ok := h.Network().(interface {
+		ListenClose(addrs ...multiaddr.Multiaddr)
+	})
+	if !ok {
+		return fmt.Errorf("p2p network of type %T can't close its listeners", h.Network())
+	}
+	swarm.ListenClose(h.Network().ListenAddresses()...)
+	return nil
+}
+
+// newQUICReuse creates the connection manager that owns the UDP sockets used by the QUIC based transports of a host.
+// libp2p doesn't close it when the host is closed, so it's closed explicitly to release the sockets
+func newQUICReuse(key crypto.PrivKey) (*quicreuse.ConnManager, error) {
+	statelessResetKey, err := libp2pconfig.PrivKeyToStatelessResetKey(key)
+	if err != nil {
+		return nil, err
+	}
+	tokenKey, err := libp2pconfig.PrivKeyToTokenGeneratorKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return quicreuse.NewConnManager(statelessResetKey, tokenKey)
+}
+
+// ListenAddrs returns the multiaddrs the local host listens on. These are stored in the db, so that peers know which
+// transports they can use to reach the local machine
+func (p2p *P2P) ListenAddrs() []string {
+	addrs := []string{}
+	for _, addr := range p2p.getHost().Network().ListenAddresses() {
+		if manet.IsIPLoopback(addr) {
+			continue
+		}
+		addrs = append(addrs, addr.String())
+	}
+	return addrs
+}
+
+// defaultAddrs returns the addresses used for machines that don't have multiaddrs stored in the db. These are the
+// ones used by the default transports
+func defaultAddrs(ip string) []string {
+	return []string{
+		fmt.Sprintf("/ip4/%s/udp/%d/quic-v1", ip, p2pPort),
+		fmt.Sprintf("/ip4/%s/tcp/%d", ip, p2pPort),
+	}
+}
+
+// dialAddrs returns the addresses of a machine. Unspecified IPs in the multiaddrs of a machine are replaced with its
+// public IP, so that the stored addresses stay valid when the IP changes
+func dialAddrs(machine Machine) []multiaddr.Multiaddr {
+	addrs := []multiaddr.Multiaddr{}
+	publicIP := net.ParseIP(machine.GetPublicIP())
+	machineAddrs := machine.GetMultiaddrs()
+	if len(machineAddrs) == 0 {
+		if publicIP == nil {
+			return addrs
+		}
+		machineAddrs = defaultAddrs(publicIP.String())
+	}
+
+	for _, addrString := range machineAddrs {
+		addr, err := multiaddr.NewMultiaddr(addrString)
+		if err != nil {
+			log.Warnf("Ignoring invalid address '%s' of machine '%s': %s", addrString, machine.GetName(), err.Error())
+			continue
+		}
+		ip, err := manet.ToIP(addr)
+		if err == nil && ip.IsUnspecified() {
+			if publicIP == nil {
+				continue
+			}
+			ipAddr, err := manet.FromIP(publicIP)
+			if err != nil {
+				continue
+			}
+			_, rest := multiaddr.SplitFirst(addr)
+			addr = ipAddr
+			if rest != nil {
+				addr = ipAddr.Encapsulate(rest)
+			}
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs
+}
@@ -0,0 +1,30 @@
+package p2p
+
+import "testing"
+
+func TestDialAddrs(t *testing.T) {
+	machine := &initMachine{name: "test", publicIP: "1.2.3.4"}
+	addrs := dialAddrs(machine)
+	if len(addrs) != 2 || addrs[0].String() != "/ip4/1.2.3.4/udp/10500/quic-v1" || addrs[1].String() != "/ip4/1.2.3.4/tcp/10500" {
+		t.Errorf("machines without multiaddrs should use the default addresses, got %v", addrs)
+	}
+
+	stored := multiaddrMachine{initMachine: machine, addrs: []string{"/ip4/0.0.0.0/tcp/443/ws", "/ip4/10.0.0.1/tcp/10500", "invalid"}}
+	addrs = dialAddrs(stored)
+	if len(addrs) != 2 || addrs[0].String() != "/ip4/1.2.3.4/tcp/443/ws" || addrs[1].String() != "/ip4/10.0.0.1/tcp/10500" {
+		t.Errorf("unspecified IPs should be replaced with the public IP, got %v", addrs)
+	}
+
+	if addrs := dialAddrs(&initMachine{name: "device"}); len(addrs) != 0 {
+		t.Errorf("machines without a public IP should not have addresses, got %v", addrs)
+	}
+}
+
+type multiaddrMachine struct {
+	*initMachine
+	addrs []string
+}
+
+func (mm multiaddrMachine) GetMultiaddrs() []string {
+	return mm.addrs
+}
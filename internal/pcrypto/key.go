@@ -8,6 +8,7 @@ import (
 	"encoding/pem"
 	"fmt"
 	"os"
+	"sync/atomic"
 
 	"filippo.io/edwards25519"
 	"github.com/bokwoon95/sq"
@@ -131,6 +132,11 @@ func (k Key) Sign(commit string) (string, error) {
 	return base36.EncodeBytes(sig), nil
 }
 
+// SignMessage returns the base64 encoded ed25519 signature of a message. It can be checked using VerifySignature
+func (k Key) SignMessage(message []byte) string {
+	return base64.StdEncoding.EncodeToString(ed25519.Sign(k.Priv, message))
+}
+
 func (k Key) Verify(commit string, signature string, publicKey string) error {
 	// Decode the base64-encoded public key string to bytes
 	pubKeyBytes, err := base64.StdEncoding.DecodeString(publicKey)
@@ -160,6 +166,19 @@ func (k Key) Verify(commit string, signature string, publicKey string) error {
 	return nil
 }
 
+// VerifyCommitSignature checks that a commit signature created using Sign belongs to the provided base64 encoded
+// ed25519 public key
+func VerifyCommitSignature(publicKey string, signature string, commit string) error {
+	pubKeyBytes, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil || len(pubKeyBytes) != ed25519.PublicKeySize {
+		return fmt.Errorf("invalid public key '%s'", publicKey)
+	}
+	if !ed25519.Verify(pubKeyBytes, []byte(commit), base36.DecodeToBytes(signature)) {
+		return fmt.Errorf("invalid signature of commit '%s' for public key '%s'", commit, publicKey)
+	}
+	return nil
+}
+
 func (k Key) PublicKey() string {
 	return k.PublicString()
 }
@@ -183,52 +202,107 @@ func (k Key) GetID() string {
 //
 
 func GetLocalKey(workdir string) (*Key, error) {
-	key := &Key{}
 	keyFilePath := workdir + "/" + privateKeyFileName
 
 	// Check if the key file exists
 	if _, err := os.Stat(keyFilePath); err == nil {
 		// Key file exists, read the file
-		keyData, err := os.ReadFile(keyFilePath)
-		if err != nil {
-			return nil, err
-		}
+		return readKeyFile(keyFilePath)
+	}
 
-		// Decode PEM block
-		block, _ := pem.Decode(keyData)
-		if block == nil || block.Type != "PRIVATE KEY" {
-			return nil, err
-		}
+	// Key file does not exist, generate a new key
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	key := &Key{Priv: privateKey, Pub: publicKey}
 
-		// Convert PEM block to ed25519.PrivateKey
-		key.Priv = ed25519.PrivateKey(block.Bytes)
-		key.Pub = key.Priv.Public().(ed25519.PublicKey)
-	} else {
-		// Key file does not exist, generate a new key
-		publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
-		if err != nil {
-			return nil, err
-		}
-		key.Priv = privateKey
-		key.Pub = publicKey
+	err = writeKeyFile(keyFilePath, key)
+	if err != nil {
+		return nil, err
+	}
 
-		// Convert privateKey to PEM block
-		block := &pem.Block{
-			Type:  "PRIVATE KEY",
-			Bytes: privateKey,
-		}
-		pemData := pem.EncodeToMemory(block)
+	return key, nil
+}
 
-		// Write the PEM block to file
-		err = os.WriteFile(keyFilePath, pemData, 0600)
-		if err != nil {
-			return nil, err
-		}
+// LocalKey holds the key of the local machine. The key is replaced when it's rotated or when the state of a device is
+// restored, so the components that use it read the current key using Get. It's also the signer of the db, which
+// always signs the commits with the current key
+type LocalKey struct {
+	key atomic.Pointer[Key]
+}
+
+// NewLocalKey creates a holder for the key of the local machine
+func NewLocalKey(key *Key) *LocalKey {
+	lk := &LocalKey{}
+	lk.key.Store(key)
+	return lk
+}
+
+// Get returns the current key
+func (lk *LocalKey) Get() *Key {
+	return lk.key.Load()
+}
+
+// Set replaces the current key. The previous key is not modified, so it stays valid for its existing users
+func (lk *LocalKey) Set(key *Key) {
+	lk.key.Store(key)
+}
+
+func (lk *LocalKey) Sign(commit string) (string, error) {
+	return lk.Get().Sign(commit)
+}
+
+func (lk *LocalKey) Verify(commit string, signature string, publicKey string) error {
+	return lk.Get().Verify(commit, signature, publicKey)
+}
+
+func (lk *LocalKey) PublicKey() string {
+	return lk.Get().PublicKey()
+}
+
+func (lk *LocalKey) GetID() string {
+	return lk.Get().GetID()
+}
+
+// SaveLocalKey replaces the key of the local machine, which is used when the state of a device is restored
+func SaveLocalKey(workdir string, key *Key) error {
+	return writeKeyFile(workdir+"/"+privateKeyFileName, key)
+}
+
+// readKeyFile reads a PEM encoded ed25519 private key from disk
+func readKeyFile(keyFilePath string) (*Key, error) {
+	keyData, err := os.ReadFile(keyFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode PEM block
+	block, _ := pem.Decode(keyData)
+	if block == nil || block.Type != "PRIVATE KEY" {
+		return nil, fmt.Errorf("failed to decode key file '%s'", keyFilePath)
 	}
 
+	// Convert PEM block to ed25519.PrivateKey
+	key := &Key{}
+	key.Priv = ed25519.PrivateKey(block.Bytes)
+	key.Pub = key.Priv.Public().(ed25519.PublicKey)
 	return key, nil
 }
 
+// writeKeyFile writes an ed25519 private key to disk, PEM encoded
+func writeKeyFile(keyFilePath string, key *Key) error {
+	// Convert privateKey to PEM block
+	block := &pem.Block{
+		Type:  "PRIVATE KEY",
+		Bytes: key.Priv,
+	}
+	pemData := pem.EncodeToMemory(block)
+
+	// Write the PEM block to file
+	return os.WriteFile(keyFilePath, pemData, 0600)
+}
+
 func ConvertPublicEd25519ToCurve25519(ed25519Key string) (wgtypes.Key, error) {
 
 	pubKey, err := base64.StdEncoding.DecodeString(ed25519Key)
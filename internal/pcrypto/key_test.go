@@ -0,0 +1,24 @@
+package pcrypto
+
+import "testing"
+
+func TestVerifyCommitSignature(t *testing.T) {
+	key := generateTestKey(t)
+	otherKey := generateTestKey(t)
+	commit := "0123456789abcdefghijklmnopqrstuv"
+
+	signature, err := key.Sign(commit)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := VerifyCommitSignature(key.PublicString(), signature, commit); err != nil {
+		t.Errorf("signature should be valid for the key that created it: %s", err.Error())
+	}
+	if err := VerifyCommitSignature(otherKey.PublicString(), signature, commit); err == nil {
+		t.Error("signature should not be valid for another key")
+	}
+	if err := VerifyCommitSignature(key.PublicString(), signature, "vutsrqponmlkjihgfedcba9876543210"); err == nil {
+		t.Error("signature should not be valid for another commit")
+	}
+}
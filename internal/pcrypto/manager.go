@@ -6,6 +6,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"os"
+	"sync"
 
 	"github.com/bokwoon95/sq"
 	"github.com/pkg/errors"
@@ -20,6 +21,9 @@ const (
 // Manager keeps track of all the keys
 type Manager struct {
 	db *db.DB
+
+	mu      sync.Mutex
+	dataKey *[dataKeySize]byte // key used for encrypting the secrets stored in the db
 }
 
 // GenerateKey generates a SSH key pair
@@ -62,6 +66,9 @@ func (sm *Manager) NewAuthFromKeyFile(keyPath string) (ssh.AuthMethod, error) {
 
 // NewKeyFromSeed takes an ed25519 key seed and return a Key
 func (sm *Manager) NewKeyFromSeed(seedStr string) (*Key, error) {
+	if IsSealed(seedStr) {
+		return nil, fmt.Errorf("%w: the seed is encrypted", ErrSecretsLocked)
+	}
 	seed, err := base64.StdEncoding.DecodeString(seedStr)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode seed: %w", err)
@@ -0,0 +1,436 @@
+package pcrypto
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/bokwoon95/sq"
+	"github.com/protosio/protos/internal/db"
+)
+
+const (
+	nextKeyFileName       = "protos.key.next"
+	rotationCheckInterval = time.Minute
+)
+
+func createKeyRotationInsertMapper(rotation KeyRotation) func() (sq.Table, func(*sq.Column)) {
+	return func() (sq.Table, func(*sq.Column)) {
+		r := sq.New[db.KEY_ROTATION]("")
+		return r, func(col *sq.Column) {
+			col.SetString(r.MACHINE_ID, rotation.MachineID)
+			col.SetString(r.OLD_PUBLIC_KEY, rotation.OldPublicKey)
+			col.SetString(r.NEW_PUBLIC_KEY, rotation.NewPublicKey)
+			col.SetString(r.SIGNATURE, rotation.Signature)
+			col.SetString(r.NEW_SIGNATURE, rotation.NewSignature)
+			col.SetTime(r.ACTIVATE_AT, rotation.ActivateAt)
+			col.SetTime(r.EXPIRES_AT, rotation.ExpiresAt)
+		}
+	}
+}
+
+func createKeyRotationQueryMapper(r db.KEY_ROTATION, predicates []sq.Predicate) func() (sq.Table, func(row *sq.Row) KeyRotation, []sq.Predicate) {
+	return func() (sq.Table, func(row *sq.Row) KeyRotation, []sq.Predicate) {
+		mapper := func(row *sq.Row) KeyRotation {
+			return KeyRotation{
+				MachineID:    row.StringField(r.MACHINE_ID),
+				OldPublicKey: row.StringField(r.OLD_PUBLIC_KEY),
+				NewPublicKey: row.StringField(r.NEW_PUBLIC_KEY),
+				Signature:    row.StringField(r.SIGNATURE),
+				NewSignature: row.StringField(r.NEW_SIGNATURE),
+				ActivateAt:   row.TimeField(r.ACTIVATE_AT),
+				ExpiresAt:    row.TimeField(r.EXPIRES_AT),
+			}
+		}
+		return r, mapper, predicates
+	}
+}
+
+// KeyRotation announces that the key of a machine is replaced by a new one. The new key becomes active at ActivateAt,
+// and the old key is still accepted until ExpiresAt. The rotation is signed by both keys, so a peer can't announce a
+// rotation to a key it doesn't hold, or from a key that isn't its own
+type KeyRotation struct {
+	MachineID    string // device machine ID or instance name
+	OldPublicKey string
+	NewPublicKey string
+	Signature    string // signature created using the old key
+	NewSignature string // countersignature created using the new key
+	ActivateAt   time.Time
+	ExpiresAt    time.Time
+}
+
+// message returns the content that is signed by the old and the new key
+func (kr KeyRotation) message() []byte {
+	return []byte(fmt.Sprintf("protos-key-rotation:%s:%s:%s:%d:%d", kr.MachineID, kr.OldPublicKey, kr.NewPublicKey, kr.ActivateAt.Unix(), kr.ExpiresAt.Unix()))
+}
+
+// VerifySignature checks an ed25519 signature of a message. The public key and the signature are base64 encoded
+func VerifySignature(publicKeyStr string, signatureStr string, message []byte) error {
+	publicKey, err := base64.StdEncoding.DecodeString(publicKeyStr)
+	if err != nil || len(publicKey) != ed25519.PublicKeySize {
+		return fmt.Errorf("invalid public key '%s'", publicKeyStr)
+	}
+	signature, err := base64.StdEncoding.DecodeString(signatureStr)
+	if err != nil {
+		return fmt.Errorf("failed to decode signature of key '%s': %w", publicKeyStr, err)
+	}
+	if !ed25519.Verify(publicKey, message, signature) {
+		return fmt.Errorf("invalid signature of key '%s'", publicKeyStr)
+	}
+	return nil
+}
+
+// Verify checks that the rotation is bound to a machine, and that it was signed by the old key and countersigned by
+// the new key
+func (kr KeyRotation) Verify() error {
+	if kr.MachineID == "" {
+		return fmt.Errorf("key rotation of '%s' is not bound to a machine", kr.OldPublicKey)
+	}
+	if kr.OldPublicKey == kr.NewPublicKey {
+		return fmt.Errorf("key rotation of '%s' doesn't change the key", kr.OldPublicKey)
+	}
+	err := VerifySignature(kr.OldPublicKey, kr.Signature, kr.message())
+	if err != nil {
+		return fmt.Errorf("invalid key rotation of '%s': %w", kr.OldPublicKey, err)
+	}
+	err = VerifySignature(kr.NewPublicKey, kr.NewSignature, kr.message())
+	if err != nil {
+		return fmt.Errorf("invalid key rotation of '%s': %w", kr.OldPublicKey, err)
+	}
+	return nil
+}
+
+// newKeyRotation creates a rotation from the current key to the new key, signed by both keys
+func newKeyRotation(machineID string, current *Key, newKey *Key, activateAt time.Time, expiresAt time.Time) KeyRotation {
+	rotation := KeyRotation{
+		MachineID:    machineID,
+		OldPublicKey: current.PublicString(),
+		NewPublicKey: newKey.PublicString(),
+		ActivateAt:   activateAt.UTC().Truncate(time.Second),
+		ExpiresAt:    expiresAt.UTC().Truncate(time.Second),
+	}
+	rotation.Signature = current.SignMessage(rotation.message())
+	rotation.NewSignature = newKey.SignMessage(rotation.message())
+	return rotation
+}
+
+// RotateKey publishes the rotation of the key of a machine in the db, signed by the current and the new key
+func (sm *Manager) RotateKey(machineID string, current *Key, newKey *Key, activateAt time.Time, expiresAt time.Time) (KeyRotation, error) {
+	rotation := newKeyRotation(machineID, current, newKey, activateAt, expiresAt)
+
+	err := db.Insert(sm.db, createKeyRotationInsertMapper(rotation))
+	if err != nil {
+		return rotation, fmt.Errorf("failed to publish key rotation: %w", err)
+	}
+	return rotation, nil
+}
+
+// GetKeyRotations returns all the key rotations that have a valid signature
+func (sm *Manager) GetKeyRotations() ([]KeyRotation, error) {
+	rotations, err := db.SelectMultiple(sm.db, createKeyRotationQueryMapper(sq.New[db.KEY_ROTATION](""), nil))
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve key rotations: %w", err)
+	}
+
+	validRotations := []KeyRotation{}
+	for _, rotation := range rotations {
+		if err := rotation.Verify(); err != nil {
+			log.Warnf("Ignoring key rotation: %s", err.Error())
+			continue
+		}
+		validRotations = append(validRotations, rotation)
+	}
+	return validRotations, nil
+}
+
+// ResolvePublicKey follows the key rotations of a machine, starting from the public key stored for it in the db, and
+// returns the key that is currently active together with all the keys that are accepted at the moment. During the
+// overlap window both the old and the new key are accepted
+func (sm *Manager) ResolvePublicKey(machineID string, publicKey string) (string, []string) {
+	rotations, err := sm.GetKeyRotations()
+	if err != nil {
+		log.Errorf("Failed to resolve public key: %s", err.Error())
+		return publicKey, []string{publicKey}
+	}
+	return resolvePublicKey(machineID, publicKey, rotations, time.Now())
+}
+
+// resolvePublicKey only uses rotations that were verified and that belong to the machine
+func resolvePublicKey(machineID string, publicKey string, allRotations []KeyRotation, now time.Time) (string, []string) {
+	current := publicKey
+	accepted := []string{publicKey}
+
+	rotations := []KeyRotation{}
+	for _, rotation := range allRotations {
+		if machineID != "" && rotation.MachineID == machineID {
+			rotations = append(rotations, rotation)
+		}
+	}
+
+	// the db might already contain the new key, in which case the old key is accepted until it expires. The stored
+	// key countersigned the rotation, so it vouches for the old key
+	for _, rotation := range rotations {
+		if rotation.NewPublicKey == publicKey && now.Before(rotation.ExpiresAt) {
+			accepted = append(accepted, rotation.OldPublicKey)
+		}
+	}
+
+	// follow the chain of rotations starting from the provided key
+	for range rotations {
+		found := false
+		for _, rotation := range rotations {
+			if rotation.OldPublicKey != current {
+				continue
+			}
+			found = true
+			accepted = append(accepted, rotation.NewPublicKey)
+			if now.Before(rotation.ActivateAt) {
+				return current, accepted
+			}
+			if !now.Before(rotation.ExpiresAt) {
+				accepted = removeKey(accepted, rotation.OldPublicKey)
+			}
+			current = rotation.NewPublicKey
+			break
+		}
+		if !found {
+			break
+		}
+	}
+
+	return current, accepted
+}
+
+func removeKey(keys []string, key string) []string {
+	filtered := []string{}
+	for _, k := range keys {
+		if k != key {
+			filtered = append(filtered, k)
+		}
+	}
+	return filtered
+}
+
+//
+// Local key rotation
+//
+
+// KeyRotator periodically rotates the local key. A new key is announced in the db, and becomes active once the
+// overlap window has passed. Until then, peers accept both the old and the new key
+type KeyRotator struct {
+	sm        *Manager
+	workdir   string
+	machineID string
+	key       *LocalKey
+	interval  time.Duration
+	overlap   time.Duration
+	activate  func(newKey *Key, expiresAt time.Time) error
+	// hold returns the reason new rotations are postponed, or an empty string if the key can be rotated
+	hold       func() (string, error)
+	holdReason string
+	mu         sync.Mutex
+}
+
+// NewKeyRotator creates a rotator for the local key of a machine. The activate function is called when the new key
+// becomes active, and is responsible for switching all the components to the new key. The previous key is accepted by
+// the peers until expiresAt
+func (sm *Manager) NewKeyRotator(workdir string, machineID string, key *LocalKey, interval time.Duration, overlap time.Duration, activate func(newKey *Key, expiresAt time.Time) error) *KeyRotator {
+	return &KeyRotator{sm: sm, workdir: workdir, machineID: machineID, key: key, interval: interval, overlap: overlap, activate: activate}
+}
+
+// SetHold sets the function that decides if new rotations are postponed, for machines whose key can't be replaced
+// at the moment. Rotations that were already published are still activated
+func (kr *KeyRotator) SetHold(hold func() (string, error)) {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+	kr.hold = hold
+}
+
+// heldBy returns the reason new rotations are postponed, or an empty string if the key can be rotated
+func (kr *KeyRotator) heldBy() (string, error) {
+	if kr.hold == nil {
+		return "", nil
+	}
+	reason, err := kr.hold()
+	if err != nil {
+		return "", fmt.Errorf("failed to check if the key rotation is postponed: %w", err)
+	}
+	return reason, nil
+}
+
+// Start starts the periodic key rotation, and returns a function that stops it
+func (kr *KeyRotator) Start() func() error {
+	stop := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(rotationCheckInterval)
+		defer ticker.Stop()
+		for {
+			if err := kr.check(); err != nil {
+				log.Errorf("Key rotation failed: %s", err.Error())
+			}
+			select {
+			case <-ticker.C:
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	return func() error {
+		close(stop)
+		return nil
+	}
+}
+
+// Rotate starts a rotation of the local key, if one is not already in progress
+func (kr *KeyRotator) Rotate() (KeyRotation, error) {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+
+	nextKey, err := kr.getNextKey()
+	if err != nil {
+		return KeyRotation{}, err
+	}
+	if nextKey != nil {
+		return KeyRotation{}, fmt.Errorf("a key rotation is already in progress")
+	}
+	reason, err := kr.heldBy()
+	if err != nil {
+		return KeyRotation{}, err
+	}
+	if reason != "" {
+		return KeyRotation{}, fmt.Errorf("the key can't be rotated: %s", reason)
+	}
+
+	return kr.startRotation()
+}
+
+func (kr *KeyRotator) getNextKey() (*Key, error) {
+	nextKeyPath := kr.workdir + "/" + nextKeyFileName
+	if _, err := os.Stat(nextKeyPath); os.IsNotExist(err) {
+		return nil, nil
+	}
+	nextKey, err := readKeyFile(nextKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read next key: %w", err)
+	}
+	return nextKey, nil
+}
+
+// startRotation generates a new key, saves it to disk and publishes the rotation
+func (kr *KeyRotator) startRotation() (KeyRotation, error) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return KeyRotation{}, fmt.Errorf("failed to generate new key: %w", err)
+	}
+	nextKey := &Key{Priv: privateKey, Pub: publicKey}
+
+	// the key is saved before publishing the rotation, so that it is not lost if the process is interrupted
+	err = writeKeyFile(kr.workdir+"/"+nextKeyFileName, nextKey)
+	if err != nil {
+		return KeyRotation{}, fmt.Errorf("failed to save next key: %w", err)
+	}
+
+	return kr.publishRotation(nextKey)
+}
+
+func (kr *KeyRotator) publishRotation(nextKey *Key) (KeyRotation, error) {
+	activateAt := time.Now().Add(kr.overlap)
+	rotation, err := kr.sm.RotateKey(kr.machineID, kr.key.Get(), nextKey, activateAt, activateAt.Add(kr.overlap))
+	if err != nil {
+		return rotation, err
+	}
+	log.Infof("Rotating local key. New key '%s' becomes active at %s", rotation.NewPublicKey, rotation.ActivateAt.String())
+	return rotation, nil
+}
+
+// check activates a pending rotation once its activation time has passed, or starts a new rotation when the current
+// key is older than the rotation interval
+func (kr *KeyRotator) check() error {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+
+	rotations, err := kr.sm.GetKeyRotations()
+	if err != nil {
+		return err
+	}
+
+	nextKey, err := kr.getNextKey()
+	if err != nil {
+		return err
+	}
+
+	if nextKey != nil {
+		var pending *KeyRotation
+		for i, rotation := range rotations {
+			if rotation.MachineID == kr.machineID && rotation.OldPublicKey == kr.key.Get().PublicString() && rotation.NewPublicKey == nextKey.PublicString() {
+				pending = &rotations[i]
+			}
+		}
+
+		// the process was interrupted before the rotation was published
+		if pending == nil {
+			_, err := kr.publishRotation(nextKey)
+			return err
+		}
+
+		if time.Now().Before(pending.ActivateAt) {
+			return nil
+		}
+
+		log.Infof("Activating new local key '%s'", nextKey.PublicString())
+		err = kr.activate(nextKey, pending.ExpiresAt)
+		if err != nil {
+			return fmt.Errorf("failed to activate new key: %w", err)
+		}
+		err = os.Rename(kr.workdir+"/"+nextKeyFileName, kr.workdir+"/"+privateKeyFileName)
+		if err != nil {
+			return fmt.Errorf("failed to replace local key: %w", err)
+		}
+		kr.key.Set(nextKey)
+		return nil
+	}
+
+	if kr.interval == 0 {
+		return nil
+	}
+
+	// the age of the current key is determined by the rotation that introduced it, or by the key file
+	keyCreated := time.Time{}
+	for _, rotation := range rotations {
+		if rotation.MachineID == kr.machineID && rotation.NewPublicKey == kr.key.Get().PublicString() {
+			keyCreated = rotation.ActivateAt
+		}
+	}
+	if keyCreated.IsZero() {
+		keyFile, err := os.Stat(kr.workdir + "/" + privateKeyFileName)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve local key file: %w", err)
+		}
+		keyCreated = keyFile.ModTime()
+	}
+
+	if time.Since(keyCreated) < kr.interval {
+		return nil
+	}
+
+	// the warning is only logged when the reason changes, since the check runs every minute
+	reason, err := kr.heldBy()
+	if err != nil {
+		return err
+	}
+	if reason != kr.holdReason && reason != "" {
+		log.Warnf("Postponing the rotation of the local key: %s", reason)
+	}
+	kr.holdReason = reason
+	if reason != "" {
+		return nil
+	}
+
+	_, err = kr.startRotation()
+	return err
+}
@@ -0,0 +1,112 @@
+package pcrypto
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+	"time"
+)
+
+func generateTestKey(t *testing.T) *Key {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Key{Priv: priv, Pub: pub}
+}
+
+func containsKey(keys []string, key string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+func TestKeyRotation(t *testing.T) {
+	now := time.Now()
+	oldKey := generateTestKey(t)
+	newKey := generateTestKey(t)
+
+	rotation := newKeyRotation("machine1", oldKey, newKey, now.Add(time.Hour), now.Add(2*time.Hour))
+	if err := rotation.Verify(); err != nil {
+		t.Fatalf("valid rotation failed verification: %s", err.Error())
+	}
+
+	// an attacker can't announce a rotation from its own key to a key it doesn't hold
+	attacker := generateTestKey(t)
+	victim := generateTestKey(t)
+	forged := newKeyRotation("machine1", attacker, attacker, now, now.Add(time.Hour))
+	forged.NewPublicKey = victim.PublicString()
+	if err := forged.Verify(); err == nil {
+		t.Error("rotation with a countersignature of a different key should fail verification")
+	}
+	forged.NewSignature = ""
+	if err := forged.Verify(); err == nil {
+		t.Error("rotation without a countersignature should fail verification")
+	}
+
+	// the signed content includes the machine ID
+	moved := rotation
+	moved.MachineID = "machine2"
+	if err := moved.Verify(); err == nil {
+		t.Error("rotation bound to a different machine should fail verification")
+	}
+	unbound := newKeyRotation("", oldKey, newKey, now, now.Add(time.Hour))
+	if err := unbound.Verify(); err == nil {
+		t.Error("rotation that is not bound to a machine should fail verification")
+	}
+
+	tampered := rotation
+	tampered.Signature = base64.StdEncoding.EncodeToString(make([]byte, ed25519.SignatureSize))
+	if err := tampered.Verify(); err == nil {
+		t.Error("rotation with an invalid signature should fail verification")
+	}
+}
+
+func TestResolvePublicKey(t *testing.T) {
+	now := time.Now()
+	key1 := generateTestKey(t)
+	key2 := generateTestKey(t)
+	key3 := generateTestKey(t)
+
+	// before activation, the old key is still the current one, but the new key is already accepted
+	pending := newKeyRotation("machine1", key1, key2, now.Add(time.Hour), now.Add(2*time.Hour))
+	current, accepted := resolvePublicKey("machine1", key1.PublicString(), []KeyRotation{pending}, now)
+	if current != key1.PublicString() || !containsKey(accepted, key2.PublicString()) {
+		t.Errorf("pending rotation should keep the old key active and accept the new one")
+	}
+
+	// rotations of other machines are ignored
+	current, accepted = resolvePublicKey("machine2", key1.PublicString(), []KeyRotation{pending}, now)
+	if current != key1.PublicString() || len(accepted) != 1 {
+		t.Errorf("rotation of a different machine should be ignored")
+	}
+
+	// during the overlap window both keys are accepted
+	active := newKeyRotation("machine1", key1, key2, now.Add(-time.Hour), now.Add(time.Hour))
+	current, accepted = resolvePublicKey("machine1", key1.PublicString(), []KeyRotation{active}, now)
+	if current != key2.PublicString() || !containsKey(accepted, key1.PublicString()) || !containsKey(accepted, key2.PublicString()) {
+		t.Errorf("both keys should be accepted during the overlap window")
+	}
+	current, accepted = resolvePublicKey("machine1", key2.PublicString(), []KeyRotation{active}, now)
+	if current != key2.PublicString() || !containsKey(accepted, key1.PublicString()) {
+		t.Errorf("old key should be accepted when the stored key is the new one")
+	}
+
+	// after expiry, the chain is followed to the last key and the old keys are dropped
+	expired := newKeyRotation("machine1", key1, key2, now.Add(-3*time.Hour), now.Add(-2*time.Hour))
+	latest := newKeyRotation("machine1", key2, key3, now.Add(-time.Hour), now.Add(-time.Minute))
+	current, accepted = resolvePublicKey("machine1", key1.PublicString(), []KeyRotation{latest, expired}, now)
+	if current != key3.PublicString() || len(accepted) != 1 {
+		t.Errorf("expected only the last key of the chain to be accepted, got '%s' and %v", current, accepted)
+	}
+
+	// chains that don't start at the stored key are not followed
+	current, accepted = resolvePublicKey("machine1", key3.PublicString(), []KeyRotation{latest, expired}, now)
+	if current != key3.PublicString() || len(accepted) != 1 {
+		t.Errorf("expired rotations should not make older keys accepted, got %v", accepted)
+	}
+}
@@ -0,0 +1,274 @@
+package pcrypto
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bokwoon95/sq"
+	"github.com/protosio/protos/internal/db"
+	"golang.org/x/crypto/nacl/box"
+	"golang.org/x/crypto/nacl/secretbox"
+)
+
+// sealedPrefix marks the values that are encrypted using the data key. The version allows changing the format later
+const sealedPrefix = "enc:v1:"
+
+const dataKeySize = 32
+const nonceSize = 24
+
+// ErrSecretsLocked is returned when the data key was not shared with the local machine. Instances never receive the
+// data key, so they can't read or write secrets
+var ErrSecretsLocked = errors.New("secrets are not available on this machine")
+
+func createDataKeyInsertMapper(publicKey string, sealedKey string) func() (sq.Table, func(*sq.Column)) {
+	return func() (sq.Table, func(*sq.Column)) {
+		k := sq.New[db.DATA_KEY]("")
+		return k, func(col *sq.Column) {
+			col.SetString(k.PUBLIC_KEY, publicKey)
+			col.SetString(k.SEALED_KEY, sealedKey)
+		}
+	}
+}
+
+func createDataKeyQueryMapper(k db.DATA_KEY, predicates []sq.Predicate) func() (sq.Table, func(row *sq.Row) dataKeyEntry, []sq.Predicate) {
+	return func() (sq.Table, func(row *sq.Row) dataKeyEntry, []sq.Predicate) {
+		mapper := func(row *sq.Row) dataKeyEntry {
+			return dataKeyEntry{
+				publicKey: row.StringField(k.PUBLIC_KEY),
+				sealedKey: row.StringField(k.SEALED_KEY),
+			}
+		}
+		return k, mapper, predicates
+	}
+}
+
+// dataKeyEntry is the data key, sealed to the public key of a device
+type dataKeyEntry struct {
+	publicKey string
+	sealedKey string
+}
+
+// IsSealed checks if a value is encrypted using the data key
+func IsSealed(value string) bool {
+	return strings.HasPrefix(value, sealedPrefix)
+}
+
+// sealDataKey encrypts the data key so that only the owner of an ed25519 public key can decrypt it
+func sealDataKey(dataKey *[dataKeySize]byte, publicKey string) (string, error) {
+	curvePublicKey, err := ConvertPublicEd25519ToCurve25519(publicKey)
+	if err != nil {
+		return "", err
+	}
+	recipient := [32]byte(curvePublicKey)
+	sealed, err := box.SealAnonymous(nil, dataKey[:], &recipient, rand.Reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to seal data key: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// openDataKey decrypts a data key that was sealed to a local key
+func openDataKey(sealedKey string, key *Key) (*[dataKeySize]byte, error) {
+	sealed, err := base64.StdEncoding.DecodeString(sealedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode sealed data key: %w", err)
+	}
+	publicKey := [32]byte(key.PublicWG())
+	privateKey := [32]byte(key.PrivateWG())
+	opened, ok := box.OpenAnonymous(nil, sealed, &publicKey, &privateKey)
+	if !ok || len(opened) != dataKeySize {
+		return nil, fmt.Errorf("failed to open sealed data key")
+	}
+	dataKey := [dataKeySize]byte(opened)
+	return &dataKey, nil
+}
+
+// encryptValue encrypts a value using the data key, and returns it in the sealed format
+func encryptValue(dataKey *[dataKeySize]byte, plaintext string) (string, error) {
+	var nonce [nonceSize]byte
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed := secretbox.Seal(nonce[:], []byte(plaintext), &nonce, dataKey)
+	return sealedPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// decryptValue decrypts a value in the sealed format
+func decryptValue(dataKey *[dataKeySize]byte, value string) (string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, sealedPrefix))
+	if err != nil {
+		return "", fmt.Errorf("failed to decode sealed value: %w", err)
+	}
+	if len(sealed) < nonceSize+secretbox.Overhead {
+		return "", fmt.Errorf("sealed value is too short")
+	}
+	nonce := [nonceSize]byte(sealed[:nonceSize])
+	plaintext, ok := secretbox.Open(nil, sealed[nonceSize:], &nonce, dataKey)
+	if !ok {
+		return "", fmt.Errorf("failed to decrypt sealed value")
+	}
+	return string(plaintext), nil
+}
+
+//
+// Secrets methods
+//
+
+// CreateDataKey creates the data key and seals it to the local key. It's only done by the first device when the
+// account is initialized, since a missing data key in the db might just mean that it wasn't synced yet
+func (sm *Manager) CreateDataKey(key *Key) error {
+	entries, err := db.SelectMultiple(sm.db, createDataKeyQueryMapper(sq.New[db.DATA_KEY](""), nil))
+	if err != nil {
+		return fmt.Errorf("failed to retrieve data keys: %w", err)
+	}
+	if len(entries) > 0 {
+		return fmt.Errorf("failed to create data key: the db already has a data key")
+	}
+
+	dataKey := new([dataKeySize]byte)
+	if _, err := rand.Read(dataKey[:]); err != nil {
+		return fmt.Errorf("failed to generate data key: %w", err)
+	}
+	sealedKey, err := sealDataKey(dataKey, key.PublicString())
+	if err != nil {
+		return err
+	}
+	err = db.Insert(sm.db, createDataKeyInsertMapper(key.PublicString(), sealedKey))
+	if err != nil {
+		return fmt.Errorf("failed to save data key: %w", err)
+	}
+	sm.setDataKey(dataKey)
+	log.Info("Created data key for encrypting secrets")
+	return nil
+}
+
+// UnlockSecrets decrypts the data key using the local key. Devices other than the first one wait until one of the
+// devices that hold the data key shares it with them, so the unlock is retried after the db is synced
+func (sm *Manager) UnlockSecrets(key *Key) error {
+	entries, err := db.SelectMultiple(sm.db, createDataKeyQueryMapper(sq.New[db.DATA_KEY](""), nil))
+	if err != nil {
+		return fmt.Errorf("failed to retrieve data keys: %w", err)
+	}
+
+	for _, entry := range entries {
+		if entry.publicKey != key.PublicString() {
+			continue
+		}
+		dataKey, err := openDataKey(entry.sealedKey, key)
+		if err != nil {
+			return err
+		}
+		sm.setDataKey(dataKey)
+		return nil
+	}
+	return fmt.Errorf("%w: the data key was not shared with this device yet", ErrSecretsLocked)
+}
+
+// SecretsUnlocked returns true if the data key is available on this machine
+func (sm *Manager) SecretsUnlocked() bool {
+	return sm.getDataKey() != nil
+}
+
+// ShareSecrets seals the data key to the provided public keys, which belong to the devices that are allowed to read
+// the secrets. Keys that already have the data key are skipped
+func (sm *Manager) ShareSecrets(publicKeys []string) error {
+	dataKey := sm.getDataKey()
+	if dataKey == nil {
+		return ErrSecretsLocked
+	}
+
+	entries, err := db.SelectMultiple(sm.db, createDataKeyQueryMapper(sq.New[db.DATA_KEY](""), nil))
+	if err != nil {
+		return fmt.Errorf("failed to retrieve data keys: %w", err)
+	}
+	shared := map[string]bool{}
+	for _, entry := range entries {
+		shared[entry.publicKey] = true
+	}
+
+	for _, publicKey := range publicKeys {
+		if shared[publicKey] {
+			continue
+		}
+		sealedKey, err := sealDataKey(dataKey, publicKey)
+		if err != nil {
+			return fmt.Errorf("failed to share data key with '%s': %w", publicKey, err)
+		}
+		err = db.Insert(sm.db, createDataKeyInsertMapper(publicKey, sealedKey))
+		if err != nil {
+			return fmt.Errorf("failed to share data key with '%s': %w", publicKey, err)
+		}
+		shared[publicKey] = true
+		log.Infof("Shared data key with '%s'", publicKey)
+	}
+	return nil
+}
+
+// ExportDataKey returns the data key, so it can be included in an export of the state
+func (sm *Manager) ExportDataKey() ([]byte, error) {
+	dataKey := sm.getDataKey()
+	if dataKey == nil {
+		return nil, ErrSecretsLocked
+	}
+	return dataKey[:], nil
+}
+
+// ImportDataKey restores a data key from an export of the state, and seals it to the local key as part of the
+// transaction of the import. It's used on a db that doesn't have a data key yet
+func (sm *Manager) ImportDataKey(q db.Querier, key *Key, dataKeyBytes []byte) error {
+	if len(dataKeyBytes) != dataKeySize {
+		return fmt.Errorf("invalid data key")
+	}
+	dataKey := [dataKeySize]byte(dataKeyBytes)
+	sealedKey, err := sealDataKey(&dataKey, key.PublicString())
+	if err != nil {
+		return err
+	}
+	err = db.Insert(q, createDataKeyInsertMapper(key.PublicString(), sealedKey))
+	if err != nil {
+		return fmt.Errorf("failed to save data key: %w", err)
+	}
+	sm.setDataKey(&dataKey)
+	return nil
+}
+
+// EncryptSecret encrypts a value before it's written to the db. Values that are already sealed and empty values are
+// returned unchanged, so that machines without the data key can write back the secrets they read
+func (sm *Manager) EncryptSecret(plaintext string) (string, error) {
+	if plaintext == "" || IsSealed(plaintext) {
+		return plaintext, nil
+	}
+	dataKey := sm.getDataKey()
+	if dataKey == nil {
+		return "", ErrSecretsLocked
+	}
+	return encryptValue(dataKey, plaintext)
+}
+
+// DecryptSecret decrypts a value read from the db. Values written before the secrets were encrypted are returned
+// unchanged
+func (sm *Manager) DecryptSecret(value string) (string, error) {
+	if !IsSealed(value) {
+		return value, nil
+	}
+	dataKey := sm.getDataKey()
+	if dataKey == nil {
+		return "", ErrSecretsLocked
+	}
+	return decryptValue(dataKey, value)
+}
+
+func (sm *Manager) getDataKey() *[dataKeySize]byte {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	return sm.dataKey
+}
+
+func (sm *Manager) setDataKey(dataKey *[dataKeySize]byte) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.dataKey = dataKey
+}
@@ -0,0 +1,60 @@
+package pcrypto
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+)
+
+func TestSecrets(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := &Key{Priv: priv, Pub: pub}
+
+	dataKey := new([dataKeySize]byte)
+	if _, err := rand.Read(dataKey[:]); err != nil {
+		t.Fatal(err)
+	}
+	sealedKey, err := sealDataKey(dataKey, key.PublicString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	openedKey, err := openDataKey(sealedKey, key)
+	if err != nil {
+		t.Fatalf("failed to open data key: %s", err.Error())
+	}
+	if *openedKey != *dataKey {
+		t.Fatal("opened data key is different from the sealed one")
+	}
+
+	otherPub, otherPriv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := openDataKey(sealedKey, &Key{Priv: otherPriv, Pub: otherPub}); err == nil {
+		t.Error("data key should not be opened using a different key")
+	}
+
+	sealed, err := encryptValue(dataKey, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsSealed(sealed) {
+		t.Errorf("value '%s' should be in the sealed format", sealed)
+	}
+	plaintext, err := decryptValue(dataKey, sealed)
+	if err != nil {
+		t.Fatalf("failed to decrypt value: %s", err.Error())
+	}
+	if plaintext != "secret" {
+		t.Errorf("decrypted value is '%s' instead of 'secret'", plaintext)
+	}
+
+	tampered := []byte(sealed)
+	tampered[len(tampered)-5] ^= 1
+	if _, err := decryptValue(dataKey, string(tampered)); err == nil {
+		t.Error("tampered value should not be decrypted")
+	}
+}
@@ -0,0 +1,54 @@
+package protosc
+
+import (
+	"fmt"
+
+	"github.com/protosio/protos/internal/db"
+)
+
+// HasChangeset checks if changes are being staged in a changeset
+func (pc *ProtosClient) HasChangeset() bool {
+	return pc.db.HasChangeset()
+}
+
+// OpenChangeset starts staging the changes made to the apps and DNS records. Staged changes only reach the instances
+// once the changeset is applied. Instances can't be staged, since deploying or removing them calls the cloud provider
+// right away, so the instance operations are refused while a changeset is open. Apps staged on an instance therefore
+// have to use an instance that already exists
+func (pc *ProtosClient) OpenChangeset() error {
+	err := pc.db.OpenChangeset()
+	if err != nil {
+		return err
+	}
+	log.Info("Opened changeset")
+	return nil
+}
+
+// PlanChangeset returns the staged changes
+func (pc *ProtosClient) PlanChangeset() ([]db.ChangesetTable, error) {
+	return pc.db.PlanChangeset()
+}
+
+// ApplyChangeset merges the staged changes into the replicated state, and returns the hash of the merge commit
+func (pc *ProtosClient) ApplyChangeset() (string, error) {
+	hash, err := pc.db.ApplyChangeset()
+	if err != nil {
+		return "", err
+	}
+	log.Infof("Applied changeset with commit '%s'", hash)
+
+	if err := pc.Refresh(); err != nil {
+		return hash, fmt.Errorf("changeset applied, but failed to refresh peers: %w", err)
+	}
+	return hash, nil
+}
+
+// DiscardChangeset drops the staged changes
+func (pc *ProtosClient) DiscardChangeset() error {
+	err := pc.db.DiscardChangeset()
+	if err != nil {
+		return err
+	}
+	log.Info("Discarded changeset")
+	return nil
+}
@@ -0,0 +1,223 @@
+package protosc
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/denisbrodbeck/machineid"
+	"github.com/protosio/protos/internal/auth"
+	"github.com/protosio/protos/internal/cloud"
+	"github.com/protosio/protos/internal/network"
+	"github.com/protosio/protos/internal/pcrypto"
+	"github.com/protosio/protos/internal/util"
+)
+
+const externalDeviceKeepAlive = 25
+
+// AddExternalDevice registers a device that can't run Protos, and returns a wg-quick config that connects it to the
+// network via the hub instance. The private key of the device is only part of the returned config, and is not stored.
+// The config contains the WireGuard key of the hub, so the hub doesn't rotate its key while it has external devices
+func (pc *ProtosClient) AddExternalDevice(name string, hub string) (auth.UserDevice, string, error) {
+	if pc.CloudManager == nil || pc.P2PManager == nil {
+		return auth.UserDevice{}, "", fmt.Errorf("protos client not ready yet")
+	}
+
+	admin, err := pc.UserManager.GetAdmin()
+	if err != nil {
+		return auth.UserDevice{}, "", fmt.Errorf("failed to retrieve admin user: %w", err)
+	}
+
+	instances, err := pc.CloudManager.GetInstances()
+	if err != nil {
+		return auth.UserDevice{}, "", fmt.Errorf("failed to retrieve instances: %w", err)
+	}
+
+	var hubInstance *cloud.InstanceInfo
+	for i, instance := range instances {
+		if instance.Name == hub {
+			hubInstance = &instances[i]
+			break
+		}
+	}
+	if hubInstance == nil {
+		return auth.UserDevice{}, "", fmt.Errorf("could not find instance '%s'", hub)
+	}
+	if hubInstance.PublicIP == "" || hubInstance.PublicKey == "" {
+		return auth.UserDevice{}, "", fmt.Errorf("instance '%s' can't be used as a hub because it has no public IP or key", hub)
+	}
+
+	// a rotation that was already announced by the hub still happens, and would replace the key in the config
+	rotations, err := pc.KeyManager.GetKeyRotations()
+	if err != nil {
+		return auth.UserDevice{}, "", fmt.Errorf("failed to retrieve key rotations: %w", err)
+	}
+	for _, rotation := range rotations {
+		if rotation.MachineID == hubInstance.GetMachineID() && time.Now().Before(rotation.ActivateAt) {
+			return auth.UserDevice{}, "", fmt.Errorf("instance '%s' is rotating its key, retry after %s", hub, rotation.ActivateAt.Format(time.RFC3339))
+		}
+	}
+
+	hubPublicKey, _ := pc.P2PManager.ResolvePublicKey(hubInstance.GetMachineID(), hubInstance.PublicKey)
+	hubWGKey, err := pcrypto.ConvertPublicEd25519ToCurve25519(hubPublicKey)
+	if err != nil {
+		return auth.UserDevice{}, "", fmt.Errorf("failed to retrieve WireGuard key for instance '%s': %w", hub, err)
+	}
+
+	deviceNetwork, err := pc.CloudManager.AllocateNetwork()
+	if err != nil {
+		return auth.UserDevice{}, "", fmt.Errorf("failed to add external device '%s': %w", name, err)
+	}
+	deviceIP := deviceNetwork.IP.Mask(deviceNetwork.Mask)
+	deviceIP[3]++
+	deviceAddress := net.IPNet{IP: deviceIP, Mask: deviceNetwork.Mask}
+
+	key, err := pc.KeyManager.GenerateKey()
+	if err != nil {
+		return auth.UserDevice{}, "", fmt.Errorf("failed to add external device '%s': %w", name, err)
+	}
+
+	randomID, err := util.GenerateRandomBytes(16)
+	if err != nil {
+		return auth.UserDevice{}, "", fmt.Errorf("failed to add external device '%s': %w", name, err)
+	}
+	machineID := "external-" + hex.EncodeToString(randomID)
+
+	device := auth.UserDevice{
+		MachineID: machineID,
+		Name:      name,
+		PublicKey: key.PublicString(),
+		Network:   deviceAddress.String(),
+		Hub:       hub,
+	}
+	err = admin.AddExternalDevice(device.MachineID, device.Name, device.PublicKey, device.Network, device.Hub)
+	if err != nil {
+		return auth.UserDevice{}, "", err
+	}
+
+	err = pc.Refresh()
+	if err != nil {
+		log.Errorf("Failed to refresh peers after adding external device '%s': %s", name, err.Error())
+	}
+
+	// DNS queries are sent to the hub, which resolves the internal domain and forwards the rest
+	dns := []string{hubInstance.InternalIP, pc.cfg.InternalDomain}
+	if hubInstance.InternalIP == "" {
+		dns = []string{}
+	}
+
+	config := strings.Builder{}
+	fmt.Fprintf(&config, "[Interface]\n")
+	fmt.Fprintf(&config, "PrivateKey = %s\n", key.PrivateWG().String())
+	fmt.Fprintf(&config, "Address = %s\n", deviceAddress.String())
+	if len(dns) > 0 {
+		fmt.Fprintf(&config, "DNS = %s\n", strings.Join(dns, ", "))
+	}
+	fmt.Fprintf(&config, "\n[Peer]\n")
+	fmt.Fprintf(&config, "PublicKey = %s\n", hubWGKey.String())
+	fmt.Fprintf(&config, "Endpoint = %s\n", net.JoinHostPort(hubInstance.PublicIP, fmt.Sprintf("%d", network.WireguardPort)))
+	fmt.Fprintf(&config, "AllowedIPs = %s\n", cloud.MeshNetwork)
+	fmt.Fprintf(&config, "PersistentKeepalive = %d\n", externalDeviceKeepAlive)
+
+	return device, config.String(), nil
+}
+
+// ApproveDevice approves a device of the user using the local key. Approved devices receive the data key at the next
+// refresh, so only a device that holds the data key can approve other devices
+func (pc *ProtosClient) ApproveDevice(name string) error {
+	if _, err := pc.KeyManager.ExportDataKey(); err != nil {
+		return fmt.Errorf("failed to approve device '%s': %w", name, err)
+	}
+
+	admin, err := pc.UserManager.GetAdmin()
+	if err != nil {
+		return fmt.Errorf("failed to retrieve admin user: %w", err)
+	}
+	err = admin.ApproveDevice(name, pc.localKey.Get())
+	if err != nil {
+		return err
+	}
+	log.Infof("Approved device '%s'", name)
+
+	return pc.Refresh()
+}
+
+// ApprovedDeviceKeys returns the public keys of the devices that were approved by the local device, or by a device
+// that was approved the same way. The approvals are stored in the db, which the instances can write as well, so only
+// chains of approvals that start at the local key are followed
+func (pc *ProtosClient) ApprovedDeviceKeys(devices []auth.UserDevice) []string {
+	trusted := map[string]bool{}
+	for _, key := range pc.localKeys() {
+		trusted[key] = true
+	}
+
+	approved := map[string]bool{}
+	for changed := true; changed; {
+		changed = false
+		for _, device := range devices {
+			if device.IsExternal() || approved[device.MachineID] {
+				continue
+			}
+			accepted := []string{device.PublicKey}
+			if !trusted[device.PublicKey] {
+				if !trusted[device.ApprovedBy] || device.VerifyApproval() != nil {
+					continue
+				}
+				// the device might have rotated its key since it was approved
+				_, accepted = pc.KeyManager.ResolvePublicKey(device.MachineID, device.ApprovedKey)
+				if !slices.Contains(accepted, device.PublicKey) {
+					continue
+				}
+			}
+			approved[device.MachineID] = true
+			for _, key := range accepted {
+				trusted[key] = true
+			}
+			changed = true
+		}
+	}
+
+	keys := []string{}
+	for _, device := range devices {
+		if approved[device.MachineID] {
+			keys = append(keys, device.PublicKey)
+		}
+	}
+	return keys
+}
+
+// localKeys returns the local key, and the keys it replaced. The previous keys are found by following the rotations
+// of the local machine backwards, which are countersigned by the newer keys
+func (pc *ProtosClient) localKeys() []string {
+	current := pc.localKey.Get().PublicString()
+	keys := []string{current}
+
+	machineID, err := machineid.ProtectedID("protos")
+	if err != nil {
+		log.Errorf("Failed to generate machine id: %s", err.Error())
+		return keys
+	}
+	rotations, err := pc.KeyManager.GetKeyRotations()
+	if err != nil {
+		log.Errorf("Failed to retrieve previous local keys: %s", err.Error())
+		return keys
+	}
+	for range rotations {
+		found := false
+		for _, rotation := range rotations {
+			if rotation.MachineID == machineID && rotation.NewPublicKey == current && !slices.Contains(keys, rotation.OldPublicKey) {
+				current = rotation.OldPublicKey
+				keys = append(keys, current)
+				found = true
+				break
+			}
+		}
+		if !found {
+			break
+		}
+	}
+	return keys
+}
@@ -0,0 +1,13 @@
+package protosc
+
+import (
+	"github.com/protosio/protos/internal/app"
+	"github.com/protosio/protos/internal/cloud"
+	"github.com/protosio/protos/internal/dns"
+)
+
+// startDNS starts the local DNS server. On MacOS, the resolver for the internal domain is registered by wg-protos
+// when the network is brought up
+func (pc *ProtosClient) startDNS(appManager *app.Manager) (func() error, error) {
+	return dns.StartServer(localDNSAddress, localDNSPort, nil, pc.cfg.InternalDomain, cloud.MeshNetwork, pc.cfg.DNSHealthAware, appManager, pc.DNSRecordManager)
+}
@@ -0,0 +1,56 @@
+package protosc
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/protosio/protos/internal/app"
+	"github.com/protosio/protos/internal/cloud"
+	"github.com/protosio/protos/internal/dns"
+	"github.com/protosio/protos/internal/network"
+)
+
+// startDNS starts the local DNS server and registers it for the internal domain. With systemd-resolved only the
+// queries for the internal domain are sent to it, otherwise it takes over resolv.conf and forwards the other queries
+func (pc *ProtosClient) startDNS(appManager *app.Manager) (func() error, error) {
+	dnsManager, err := network.NewDNSManager()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create DNS manager: %w", err)
+	}
+
+	port := localDNSPort
+	upstreams := []string{}
+	if !dnsManager.UsesResolved() {
+		port = 53
+		upstream, err := dnsManager.Upstream()
+		if err != nil {
+			log.Warnf("Using '%s' as upstream DNS servers: %s", strings.Join(pc.cfg.ExternalDNS, ", "), err.Error())
+			upstreams = pc.cfg.ExternalDNS
+		} else {
+			// the configured servers are used as a fallback for the system one
+			upstreams = append([]string{upstream}, pc.cfg.ExternalDNS...)
+		}
+	}
+
+	dnsStopper, err := dns.StartServer(localDNSAddress, port, upstreams, pc.cfg.InternalDomain, cloud.MeshNetwork, pc.cfg.DNSHealthAware, appManager, pc.DNSRecordManager)
+	if err != nil {
+		return nil, err
+	}
+	err = dnsManager.AddDomainServer(pc.cfg.InternalDomain, net.ParseIP(localDNSAddress), port)
+	if err != nil {
+		if stopErr := dnsStopper(); stopErr != nil {
+			log.Errorf("Failed to stop DNS server: %s", stopErr.Error())
+		}
+		return nil, fmt.Errorf("failed to register DNS server: %w", err)
+	}
+
+	stopper := func() error {
+		err := dnsManager.DelDomainServer(pc.cfg.InternalDomain)
+		if err != nil {
+			log.Errorf("Failed to remove DNS configuration: %s", err.Error())
+		}
+		return dnsStopper()
+	}
+	return stopper, nil
+}
@@ -0,0 +1,157 @@
+package protosc
+
+import (
+	"fmt"
+
+	"github.com/protosio/protos/internal/db"
+	"github.com/protosio/protos/internal/p2p"
+	"github.com/protosio/protos/internal/pcrypto"
+)
+
+// HistoryCommit is a commit of the replicated db, together with the machine that signed it
+type HistoryCommit struct {
+	db.Commit
+	Signer     string // name of the machine, empty if the signature of the commit couldn't be verified
+	SignerType string // instance or device
+}
+
+type signer struct {
+	name        string
+	machineType string
+	machineID   string
+	publicKey   string
+}
+
+// signers maps the peer IDs and public keys of all the machines to their names. Keys that were replaced by a key
+// rotation are included, so that older commits are attributed to the right machine
+func (pc *ProtosClient) signers() (map[string]signer, error) {
+	keys := map[string]signer{}
+	if pc.CloudManager != nil {
+		instances, err := pc.CloudManager.GetInstances()
+		if err != nil {
+			return nil, err
+		}
+		for _, instance := range instances {
+			keys[instance.PublicKey] = signer{name: instance.Name, machineType: p2p.MachineTypeInstance, machineID: instance.GetMachineID()}
+		}
+	}
+	usr, err := pc.UserManager.GetAdmin()
+	if err != nil {
+		return nil, err
+	}
+	for _, device := range usr.GetDevices() {
+		keys[device.PublicKey] = signer{name: device.Name, machineType: p2p.MachineTypeDevice, machineID: device.MachineID}
+	}
+
+	// rotations are followed in both directions, since the db might store either the old or the new key. Only the
+	// rotations of the machine that holds the key are followed, and both keys signed them
+	rotations, err := pc.KeyManager.GetKeyRotations()
+	if err != nil {
+		return nil, err
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, rotation := range rotations {
+			oldSigner, oldFound := keys[rotation.OldPublicKey]
+			newSigner, newFound := keys[rotation.NewPublicKey]
+			if oldFound && !newFound && oldSigner.machineID == rotation.MachineID {
+				keys[rotation.NewPublicKey] = oldSigner
+				changed = true
+			} else if newFound && !oldFound && newSigner.machineID == rotation.MachineID {
+				keys[rotation.OldPublicKey] = newSigner
+				changed = true
+			}
+		}
+	}
+
+	signers := map[string]signer{}
+	for publicKey, s := range keys {
+		s.publicKey = publicKey
+		signers[publicKey] = s
+		peerID, err := p2p.PublicKeyToPeerID(publicKey)
+		if err != nil {
+			continue
+		}
+		signers[peerID] = s
+	}
+	return signers, nil
+}
+
+// historyCommit attributes a commit to the machine that signed it. The committer only names the candidate machine, so
+// the commit is attributed to it only if one of the commit signatures was created using its key
+func (pc *ProtosClient) historyCommit(commit db.Commit, signers map[string]signer, signatures map[string][]string) HistoryCommit {
+	hc := HistoryCommit{Commit: commit}
+	s, found := signers[commit.Committer]
+	if !found {
+		s, found = signers[commit.Email]
+	}
+	if !found {
+		return hc
+	}
+	for _, signature := range signatures[commit.Hash] {
+		if pcrypto.VerifyCommitSignature(s.publicKey, signature, commit.Hash) == nil {
+			hc.Signer = s.name
+			hc.SignerType = s.machineType
+			break
+		}
+	}
+	return hc
+}
+
+// GetHistory returns the most recent changes made to the replicated db
+func (pc *ProtosClient) GetHistory(limit int) ([]HistoryCommit, error) {
+	commits, err := pc.db.Log(limit)
+	if err != nil {
+		return nil, err
+	}
+	signers, err := pc.signers()
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve commit signers: %w", err)
+	}
+	signatures, err := pc.db.CommitSignatures()
+	if err != nil {
+		return nil, err
+	}
+
+	history := []HistoryCommit{}
+	for _, commit := range commits {
+		history = append(history, pc.historyCommit(commit, signers, signatures))
+	}
+	return history, nil
+}
+
+// GetHistoryCommit returns a change made to the replicated db, and the tables it changed
+func (pc *ProtosClient) GetHistoryCommit(hash string) (HistoryCommit, []db.TableChange, error) {
+	commit, err := pc.db.GetCommit(hash)
+	if err != nil {
+		return HistoryCommit{}, nil, err
+	}
+	changes, err := pc.db.ChangedTables(hash)
+	if err != nil {
+		return HistoryCommit{}, nil, err
+	}
+	signers, err := pc.signers()
+	if err != nil {
+		return HistoryCommit{}, nil, fmt.Errorf("failed to resolve commit signers: %w", err)
+	}
+	signatures, err := pc.db.CommitSignatures()
+	if err != nil {
+		return HistoryCommit{}, nil, err
+	}
+	return pc.historyCommit(commit, signers, signatures), changes, nil
+}
+
+// DiffHistoryCommit returns the rows of a table changed by a commit
+func (pc *ProtosClient) DiffHistoryCommit(hash string, table string) ([]db.RowDiff, error) {
+	return pc.db.DiffTable(hash, table)
+}
+
+// RevertHistoryCommit undoes the changes made by a commit, using a new commit
+func (pc *ProtosClient) RevertHistoryCommit(hash string) (string, error) {
+	revertHash, err := pc.db.Revert(hash)
+	if err != nil {
+		return "", err
+	}
+	log.Infof("Reverted commit '%s' with commit '%s'", hash, revertHash)
+	return revertHash, nil
+}
@@ -0,0 +1,49 @@
+package protosc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+const wireguardPingTimeout = 5 * time.Second
+
+// PingResult holds the outcome of pinging a peer over the p2p and WireGuard paths
+type PingResult struct {
+	P2PRTT       time.Duration
+	P2PErr       error
+	WireguardRTT time.Duration
+	WireguardErr error
+}
+
+// PingPeer tests the p2p and WireGuard paths towards a peer separately. The WireGuard path is tested by sending a DNS
+// query through the tunnel, to the DNS server of the instance
+func (pc *ProtosClient) PingPeer(ctx context.Context, name string) PingResult {
+	result := PingResult{}
+	result.P2PRTT, result.P2PErr = pc.P2PManager.PingPeer(ctx, name)
+	result.WireguardRTT, result.WireguardErr = pc.pingWireguard(name)
+	return result
+}
+
+func (pc *ProtosClient) pingWireguard(name string) (time.Duration, error) {
+	instance, err := pc.CloudManager.GetInstance(name)
+	if err != nil {
+		return 0, fmt.Errorf("WireGuard ping is only supported for instances: %w", err)
+	}
+	if instance.InternalIP == "" {
+		return 0, fmt.Errorf("instance '%s' does not have an internal IP", name)
+	}
+
+	msg := new(dns.Msg)
+	msg.SetQuestion(dns.Fqdn("protos."+pc.cfg.InternalDomain), dns.TypeA)
+	dnsClient := &dns.Client{Net: "udp", Timeout: wireguardPingTimeout}
+	_, rtt, err := dnsClient.Exchange(msg, net.JoinHostPort(instance.InternalIP, strconv.Itoa(53)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to reach instance '%s' via WireGuard: %w", name, err)
+	}
+	return rtt, nil
+}
@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/denisbrodbeck/machineid"
 	"github.com/pkg/errors"
@@ -23,6 +24,7 @@ import (
 	"github.com/protosio/protos/internal/network"
 	"github.com/protosio/protos/internal/p2p"
 	"github.com/protosio/protos/internal/pcrypto"
+	"github.com/protosio/protos/internal/publicdns"
 	"github.com/protosio/protos/internal/release"
 	"github.com/protosio/protos/internal/runtime"
 
@@ -53,15 +55,17 @@ type ProtosClient struct {
 	version           string
 	wg                sync.WaitGroup
 	capabilityManager *capability.Manager
-	localKey          *pcrypto.Key
-
-	UserManager    *auth.UserManager
-	KeyManager     *pcrypto.Manager
-	AppManager     *app.Manager
-	NetworkManager *network.Manager
-	CloudManager   *cloud.Manager
-	P2PManager     *p2p.P2P
-	Meta           *meta.Meta
+	localKey          *pcrypto.LocalKey
+	publicDNS         *publicdns.Syncer
+
+	UserManager      *auth.UserManager
+	KeyManager       *pcrypto.Manager
+	AppManager       *app.Manager
+	NetworkManager   *network.Manager
+	CloudManager     *cloud.Manager
+	DNSRecordManager *dns.RecordManager
+	P2PManager       *p2p.P2P
+	Meta             *meta.Meta
 }
 
 func New(dataPath string, version string) (*ProtosClient, error) {
@@ -98,11 +102,11 @@ func New(dataPath string, version string) (*ProtosClient, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to get local key: %w", err)
 	}
-	protosClient.localKey = lkey
+	protosClient.localKey = pcrypto.NewLocalKey(lkey)
 
 	// open db
 	protosDB := "protos.db"
-	protosClient.db, err = db.Open(dataPath, protosDB, lkey)
+	protosClient.db, err = db.Open(dataPath, protosDB, protosClient.localKey, db.ScopeClient)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open db during configuration: %w", err)
 	}
@@ -114,6 +118,8 @@ func New(dataPath string, version string) (*ProtosClient, error) {
 	userManager := auth.CreateUserManager(protosClient.db, keyManager, capabilityManager, protosClient)
 
 	protosClient.UserManager = userManager
+	// apps and DNS records describe the desired state of the fleet, so their changes can be staged in a changeset
+	protosClient.DNSRecordManager = dns.CreateRecordManager(protosClient.db.Staged())
 	protosClient.KeyManager = keyManager
 	protosClient.capabilityManager = capabilityManager
 	protosClient.Meta = metaClient
@@ -175,14 +181,17 @@ func (pc *ProtosClient) Init(username string, name string, organization string)
 		return fmt.Errorf("failed to add user. Error while generating machine id: %w", err)
 	}
 
-	adminUser, err := pc.UserManager.CreateUser(username, name, true)
+	device := auth.UserDevice{MachineID: machineID, Name: host, PublicKey: pc.localKey.Get().PublicString(), Network: "10.100.0.1/24"}
+	// the first device creates the data key, so it approves itself
+	device.Approve(pc.localKey.Get())
+	_, err = pc.UserManager.CreateUser(username, name, true, device)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to add user: %w", err)
 	}
 
-	err = adminUser.AddDevice(machineID, host, pc.localKey.PublicString(), "10.100.0.1/24")
+	err = pc.KeyManager.CreateDataKey(pc.localKey.Get())
 	if err != nil {
-		return fmt.Errorf("failed to add user. Error while creating user device: %w", err)
+		return fmt.Errorf("failed to init secrets: %w", err)
 	}
 
 	// saving the key to disk
@@ -199,15 +208,16 @@ func (pc *ProtosClient) FinishInit() error {
 	}
 
 	appRuntime := runtime.Create(networkManager, pc.cfg.RuntimeEndpoint)
-	appManager := app.CreateManager(app.TypeProtosc, appRuntime, pc.db, pc.Meta, pc.capabilityManager)
+	// like the DNS records, the apps can be changed in a changeset
+	appManager := app.CreateManager(app.TypeProtosc, appRuntime, pc.db.Staged(), pc.Meta, pc.capabilityManager)
 
-	p2pManager, err := p2p.NewManager(pc.localKey, appManager, false, pc.db)
+	p2pManager, err := p2p.NewManager(pc.localKey.Get(), appManager, networkManager, pc, pc.KeyManager, pc.capabilityManager, false, false, pc.cfg.P2P, pc.db)
 	if err != nil {
 		log.Fatalf("Failed to create p2p manager: %s", err.Error())
 	}
 	pc.P2PManager = p2pManager
 
-	p2pStopper, err := p2pManager.StartServer(pc.Meta)
+	p2pStopper, err := p2pManager.StartServer(pc.Meta, false)
 	if err != nil {
 		log.Fatalf("Failed to start p2p server: %s", err.Error())
 	}
@@ -228,12 +238,52 @@ func (pc *ProtosClient) FinishInit() error {
 		log.Fatalf("Failed to create cloud manager: %s", err.Error())
 	}
 
-	dnsStopper := dns.StartServer(localDNSAddress, localDNSPort, "", pc.cfg.InternalDomain, appManager)
-	pc.stoppers["dns"] = dnsStopper
+	// the secrets in the db are encrypted using a data key, which is sealed to every approved device of the user
+	err = pc.unlockSecrets(cloudManager)
+	if errors.Is(err, pcrypto.ErrSecretsLocked) {
+		log.Warnf("Secrets are locked until the data key is shared with this device. Approve it from a device that holds the data key")
+	} else if err != nil {
+		log.Errorf("Failed to unlock secrets: %s", err.Error())
+	}
+
+	dnsStopper, err := pc.startDNS(appManager)
+	if err != nil {
+		log.Errorf("Failed to configure DNS: %s", err.Error())
+	} else {
+		pc.stoppers["dns"] = dnsStopper
+	}
 	pc.AppManager = appManager
 	pc.CloudManager = cloudManager
 	pc.NetworkManager = networkManager
 
+	// keep the public records of the apps with the PublicDNS capability in sync with their placement
+	publicDNSProvider, err := publicdns.NewProvider(pc.cfg.PublicDNS)
+	if err != nil {
+		log.Errorf("Failed to configure public DNS: %s", err.Error())
+	} else if publicDNSProvider != nil {
+		pc.publicDNS = publicdns.NewSyncer(publicDNSProvider, appManager, cloudManager)
+		pc.stoppers["publicdns"] = pc.publicDNS.Start()
+	}
+
+	// periodically rotate the local key. Peers accept both keys until the new one becomes active
+	keyRotator := pc.KeyManager.NewKeyRotator(pc.cfg.WorkDir, currentDevice.MachineID, pc.localKey, pc.cfg.KeyRotationInterval, pc.cfg.KeyRotationOverlap, pc.activateKey)
+	pc.stoppers["keyrotation"] = keyRotator.Start()
+
+	// the peers and the public records follow the changes made by the instances and the other devices
+	events, unsubscribe := p2pManager.Subscribe(p2p.EventDBHead, p2p.EventInstanceStatus, p2p.EventAppStatus)
+	pc.stoppers["events"] = func() error {
+		unsubscribe()
+		return nil
+	}
+	go func() {
+		for event := range events {
+			log.Debugf("Received '%s' event from '%s'", p2p.EventType(event), event.Source)
+			if err := pc.Refresh(); err != nil {
+				log.Errorf("Failed to refresh peers: %s", err.Error())
+			}
+		}
+	}()
+
 	pc.Refresh()
 
 	return nil
@@ -247,6 +297,12 @@ func (pc *ProtosClient) Refresh() error {
 		return nil
 	}
 
+	// the data key might have been shared with this device by another device since the last refresh
+	err := pc.unlockSecrets(pc.CloudManager)
+	if err != nil && !errors.Is(err, pcrypto.ErrSecretsLocked) {
+		log.Errorf("Failed to unlock secrets: %s", err.Error())
+	}
+
 	instances, err := pc.CloudManager.GetInstances()
 	if err != nil {
 		return fmt.Errorf("failed to retrieve instances: %w", err)
@@ -254,18 +310,32 @@ func (pc *ProtosClient) Refresh() error {
 
 	peers := []p2p.Machine{}
 	for _, instance := range instances {
+		// instances that are still being deployed don't have a key yet
+		if instance.PublicKey == "" {
+			continue
+		}
 		peers = append(peers, instance)
 	}
 
 	admin, err := pc.UserManager.GetAdmin()
 	if err == nil {
 		userDevices := admin.GetDevices()
-		err = pc.NetworkManager.ConfigurePeers(instances, userDevices)
+		err = pc.NetworkManager.ConfigurePeers(instances, userDevices, pc.P2PManager)
 		if err != nil {
 			return fmt.Errorf("failed to configure network peers: %w", err)
 		}
-		for _, device := range userDevices {
-			peers = append(peers, &device)
+		for i := range userDevices {
+			// external devices don't run Protos, so they are only configured as WireGuard peers
+			if userDevices[i].IsExternal() {
+				continue
+			}
+			peers = append(peers, &userDevices[i])
+		}
+
+		// devices that were approved since the last refresh receive the data key, so they can read the secrets
+		err = pc.KeyManager.ShareSecrets(pc.ApprovedDeviceKeys(userDevices))
+		if err != nil && !errors.Is(err, pcrypto.ErrSecretsLocked) {
+			log.Errorf("Failed to share secrets with devices: %s", err.Error())
 		}
 	}
 	if err != nil {
@@ -279,9 +349,65 @@ func (pc *ProtosClient) Refresh() error {
 		return fmt.Errorf("failed to configure network peers: %w", err)
 	}
 
+	// app placement or instance IPs might have changed
+	if pc.publicDNS != nil {
+		pc.publicDNS.Trigger()
+	}
+
 	return nil
 }
 
+// unlockSecrets opens the data key, unless it's already available, and encrypts the secrets that were written to the
+// db before it was available
+func (pc *ProtosClient) unlockSecrets(cloudManager *cloud.Manager) error {
+	if pc.KeyManager.SecretsUnlocked() {
+		return nil
+	}
+	err := pc.KeyManager.UnlockSecrets(pc.localKey.Get())
+	if err != nil {
+		return err
+	}
+	log.Info("Unlocked secrets")
+	return cloudManager.SealSecrets()
+}
+
+// activateKey switches the current device to a new key, once a key rotation becomes active
+func (pc *ProtosClient) activateKey(newKey *pcrypto.Key, expiresAt time.Time) error {
+	admin, err := pc.UserManager.GetAdmin()
+	if err != nil {
+		return fmt.Errorf("failed to retrieve admin user: %w", err)
+	}
+
+	currentDevice, err := admin.GetCurrentDevice()
+	if err != nil {
+		return fmt.Errorf("failed to get current device: %w", err)
+	}
+
+	// the new key receives the data key before it becomes active, so the secrets stay readable after a restart
+	err = pc.KeyManager.ShareSecrets([]string{newKey.PublicString()})
+	if err != nil && !errors.Is(err, pcrypto.ErrSecretsLocked) {
+		return fmt.Errorf("failed to share secrets with the new key: %w", err)
+	}
+
+	// the p2p identity is switched first, so that a failure to move the listeners leaves everything on the current key
+	err = pc.P2PManager.RotateIdentity(newKey, expiresAt)
+	if err != nil {
+		return err
+	}
+
+	err = admin.SetDevicePublicKey(currentDevice.MachineID, newKey.PublicString())
+	if err != nil {
+		return err
+	}
+
+	return pc.NetworkManager.SetPrivateKey(newKey.PrivateWG())
+}
+
+// GetInternalDomain returns the domain used for resolving apps and user defined records
+func (pc *ProtosClient) GetInternalDomain() string {
+	return pc.cfg.InternalDomain
+}
+
 func (pc *ProtosClient) IsInitialized() bool {
 	_, err := pc.UserManager.GetAdmin()
 	if err != nil {
@@ -0,0 +1,278 @@
+package protosc
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/denisbrodbeck/machineid"
+	"github.com/protosio/protos/internal/app"
+	"github.com/protosio/protos/internal/auth"
+	"github.com/protosio/protos/internal/cloud"
+	"github.com/protosio/protos/internal/db"
+	"github.com/protosio/protos/internal/pcrypto"
+	"github.com/protosio/protos/internal/state"
+	"github.com/protosio/protos/internal/util"
+)
+
+// ExportState returns a bundle with the users, devices, instances, apps, cloud providers and keys of the account. The
+// bundle contains secrets, so it should be encrypted using a passphrase
+func (pc *ProtosClient) ExportState(passphrase string) ([]byte, error) {
+	if pc.CloudManager == nil || pc.AppManager == nil {
+		return nil, fmt.Errorf("protos client is not initialized")
+	}
+	if pc.db.HasChangeset() {
+		return nil, fmt.Errorf("changes are staged in a changeset. Apply or discard the changeset before exporting the state")
+	}
+
+	dataKey, err := pc.KeyManager.ExportDataKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to export data key: %w", err)
+	}
+
+	st := state.State{
+		ProtosVersion: pc.version,
+		CreatedAt:     time.Now().UTC(),
+		DeviceKey:     pc.localKey.Get().Seed(),
+		DataKey:       dataKey,
+	}
+
+	admin, err := pc.UserManager.GetAdmin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve admin user: %w", err)
+	}
+	user := state.User{Username: admin.Username, Name: admin.Name, IsAdmin: admin.IsAdmin()}
+	for _, device := range admin.GetDevices() {
+		user.Devices = append(user.Devices, state.Device{
+			MachineID:   device.MachineID,
+			Name:        device.Name,
+			PublicKey:   device.PublicKey,
+			Network:     device.Network,
+			Hub:         device.Hub,
+			ApprovedKey: device.ApprovedKey,
+			ApprovedBy:  device.ApprovedBy,
+			Approval:    device.Approval,
+		})
+	}
+	st.Users = append(st.Users, user)
+
+	instances, err := pc.CloudManager.GetInstances()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve instances: %w", err)
+	}
+	for _, instance := range instances {
+		st.Instances = append(st.Instances, state.Instance{
+			VMID:          instance.VMID,
+			Name:          instance.Name,
+			SSHKeySeed:    instance.SSHKeySeed,
+			PublicKey:     instance.PublicKey,
+			PublicIP:      instance.PublicIP,
+			Multiaddrs:    instance.Multiaddrs,
+			InternalIP:    instance.InternalIP,
+			CloudType:     instance.CloudType,
+			CloudName:     instance.CloudName,
+			Location:      instance.Location,
+			Network:       instance.Network,
+			ProtosVersion: instance.ProtosVersion,
+			Architecture:  instance.Architecture,
+		})
+	}
+
+	providers, err := pc.CloudManager.GetProviders()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve cloud providers: %w", err)
+	}
+	for _, provider := range providers {
+		providerInfo, ok := provider.(*cloud.ProviderInfo)
+		if !ok {
+			return nil, fmt.Errorf("failed to export cloud provider '%s'", provider.NameStr())
+		}
+		st.CloudProviders = append(st.CloudProviders, state.CloudProvider{
+			Name: providerInfo.Name,
+			Type: providerInfo.Type.String(),
+			Auth: providerInfo.Auth,
+		})
+	}
+
+	apps, err := pc.AppManager.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve apps: %w", err)
+	}
+	for _, app := range apps {
+		ports := []string{}
+		for _, port := range app.Ports {
+			ports = append(ports, port.String())
+		}
+		st.Apps = append(st.Apps, state.App{
+			ID:            app.ID,
+			Name:          app.Name,
+			InstallerRef:  app.InstallerRef,
+			InstanceName:  app.InstanceName,
+			DesiredStatus: app.DesiredStatus,
+			IP:            app.IP.String(),
+			Persistence:   app.Persistence,
+			Ports:         ports,
+			Capabilities:  app.Capabilities,
+		})
+	}
+
+	records, err := pc.DNSRecordManager.GetRecords()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve DNS records: %w", err)
+	}
+	for _, record := range records {
+		st.DNSRecords = append(st.DNSRecords, state.DNSRecord{Name: record.Name, Type: record.Type, Value: record.Value})
+	}
+
+	bundle, err := state.Seal(st, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create state bundle: %w", err)
+	}
+	log.Infof("Exported state with %d instances, %d apps and %d cloud providers", len(st.Instances), len(st.Apps), len(st.CloudProviders))
+	return bundle, nil
+}
+
+// ImportState rebuilds the db of a protos client that is not initialized yet, using a bundle created by ExportState.
+// The client takes over the key of the exporting device, so the instances accept it as the same device. The whole
+// state is saved in a single transaction, so a failed import leaves the client uninitialized and can be retried. The
+// mesh is re-established once the initialization finishes
+func (pc *ProtosClient) ImportState(data []byte, passphrase string) error {
+	if _, err := pc.UserManager.GetAdmin(); err == nil {
+		return fmt.Errorf("protos client is already initialized. The state can only be imported on a fresh client")
+	}
+
+	st, err := state.Open(data, passphrase)
+	if err != nil {
+		return err
+	}
+
+	key, err := pc.KeyManager.NewKeyFromSeed(base64.StdEncoding.EncodeToString(st.DeviceKey))
+	if err != nil {
+		return fmt.Errorf("failed to import device key: %w", err)
+	}
+
+	machineID, err := machineid.ProtectedID("protos")
+	if err != nil {
+		return fmt.Errorf("failed to import state. Error while generating machine id: %w", err)
+	}
+
+	// the commit of the import is authored by the imported key, which the instances know. If the import fails, the
+	// previous key is restored
+	previousKey := pc.localKey.Get()
+	err = pcrypto.SaveLocalKey(pc.cfg.WorkDir, key)
+	if err != nil {
+		return fmt.Errorf("failed to save device key: %w", err)
+	}
+	pc.localKey.Set(key)
+	err = pc.db.Tx("Import state", func(tx *db.Tx) error {
+		return pc.importState(tx, st, machineID)
+	})
+	if err != nil {
+		pc.localKey.Set(previousKey)
+		if saveErr := pcrypto.SaveLocalKey(pc.cfg.WorkDir, previousKey); saveErr != nil {
+			log.Errorf("Failed to restore device key: %s", saveErr.Error())
+		}
+		return fmt.Errorf("failed to import state: %w", err)
+	}
+
+	log.Infof("Imported state created at %s by protos %s, with %d instances, %d apps and %d cloud providers", st.CreatedAt.Format(time.RFC3339), st.ProtosVersion, len(st.Instances), len(st.Apps), len(st.CloudProviders))
+	pc.SetInitialized()
+
+	return nil
+}
+
+// importState saves the contents of an imported state in the transaction of the import
+func (pc *ProtosClient) importState(tx *db.Tx, st state.State, machineID string) error {
+	err := pc.KeyManager.ImportDataKey(tx, pc.localKey.Get(), st.DataKey)
+	if err != nil {
+		return fmt.Errorf("failed to import data key: %w", err)
+	}
+
+	for _, u := range st.Users {
+		devices := []auth.UserDevice{}
+		for _, device := range u.Devices {
+			id := device.MachineID
+			if device.PublicKey == pc.localKey.Get().PublicString() {
+				// the exporting device is now this machine
+				id = machineID
+			}
+			devices = append(devices, auth.UserDevice{
+				MachineID:   id,
+				Name:        device.Name,
+				PublicKey:   device.PublicKey,
+				Network:     device.Network,
+				Hub:         device.Hub,
+				ApprovedKey: device.ApprovedKey,
+				ApprovedBy:  device.ApprovedBy,
+				Approval:    device.Approval,
+			})
+		}
+		_, err := pc.UserManager.ImportUser(tx, u.Username, u.Name, u.IsAdmin, devices...)
+		if err != nil {
+			return fmt.Errorf("failed to import user '%s': %w", u.Username, err)
+		}
+	}
+
+	for _, record := range st.DNSRecords {
+		err := pc.DNSRecordManager.ImportRecord(tx, record.Name, record.Type, record.Value)
+		if err != nil {
+			return fmt.Errorf("failed to import DNS record '%s': %w", record.Name, err)
+		}
+	}
+
+	for _, provider := range st.CloudProviders {
+		err := cloud.ImportProvider(tx, pc.KeyManager, provider.Name, provider.Type, provider.Auth)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, instance := range st.Instances {
+		err := cloud.ImportInstance(tx, pc.KeyManager, cloud.InstanceInfo{
+			VMID:          instance.VMID,
+			Name:          instance.Name,
+			SSHKeySeed:    instance.SSHKeySeed,
+			PublicKey:     instance.PublicKey,
+			PublicIP:      instance.PublicIP,
+			Multiaddrs:    instance.Multiaddrs,
+			InternalIP:    instance.InternalIP,
+			CloudType:     instance.CloudType,
+			CloudName:     instance.CloudName,
+			Location:      instance.Location,
+			Network:       instance.Network,
+			ProtosVersion: instance.ProtosVersion,
+			Architecture:  instance.Architecture,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, a := range st.Apps {
+		ports := []util.Port{}
+		for _, portStr := range a.Ports {
+			port, err := util.ParsePort(portStr)
+			if err != nil {
+				return fmt.Errorf("failed to import port of app '%s': %w", a.Name, err)
+			}
+			ports = append(ports, port)
+		}
+		err := app.Import(tx, app.App{
+			ID:            a.ID,
+			Name:          a.Name,
+			InstallerRef:  a.InstallerRef,
+			InstanceName:  a.InstanceName,
+			DesiredStatus: a.DesiredStatus,
+			IP:            net.ParseIP(a.IP),
+			Persistence:   a.Persistence,
+			Ports:         ports,
+			Capabilities:  a.Capabilities,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/Masterminds/semver"
 
@@ -21,6 +22,7 @@ import (
 	"github.com/protosio/protos/internal/meta"
 	"github.com/protosio/protos/internal/network"
 	"github.com/protosio/protos/internal/p2p"
+	p2pproto "github.com/protosio/protos/internal/p2p/proto"
 	"github.com/protosio/protos/internal/pcrypto"
 	"github.com/protosio/protos/internal/runtime"
 	"github.com/protosio/protos/internal/util"
@@ -64,14 +66,15 @@ func StartUp(configFile string, version *semver.Version, devmode bool) {
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
 	go catchSignals(sigs, &wg)
 
-	// retrieve local key
-	lkey, err := pcrypto.GetLocalKey(cfg.WorkDir)
+	// retrieve local key. It's replaced when the key is rotated, so the components read it through the holder
+	key, err := pcrypto.GetLocalKey(cfg.WorkDir)
 	if err != nil {
 		log.Fatal(fmt.Errorf("failed to get local key: %w", err))
 	}
+	lkey := pcrypto.NewLocalKey(key)
 
 	// open databse
-	dbcli, err := db.Open(cfg.WorkDir, "db", lkey)
+	dbcli, err := db.Open(cfg.WorkDir, "db", lkey, db.ScopeInstance)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -94,11 +97,12 @@ func StartUp(configFile string, version *semver.Version, devmode bool) {
 	peerConfigurator.UserManager = um
 	appManager := app.CreateManager(app.TypeProtosd, appRuntime, dbcli, m, cm)
 
-	p2pManager, err := p2p.NewManager(lkey, appManager, m.InitMode(), dbcli)
+	p2pManager, err := p2p.NewManager(lkey.Get(), appManager, networkManager, peerConfigurator, sm, cm, m.InitMode(), true, cfg.P2P, dbcli)
 	if err != nil {
 		log.Fatal(err)
 	}
 	peerConfigurator.P2PManager = p2pManager
+	appManager.SetStatusPublisher(p2pManager)
 
 	cloudManager, err := cloud.CreateManager(dbcli, um, sm, p2pManager, peerConfigurator, m.InstanceName)
 	if err != nil {
@@ -106,7 +110,7 @@ func StartUp(configFile string, version *semver.Version, devmode bool) {
 	}
 	peerConfigurator.CloudManager = cloudManager
 
-	p2pStopper, err := p2pManager.StartServer(m)
+	p2pStopper, err := p2pManager.StartServer(m, devmode)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -146,22 +150,105 @@ func StartUp(configFile string, version *semver.Version, devmode bool) {
 	}
 
 	// perform network initialization
-	err = networkManager.Init(network, internalIP, lkey.PrivateWG(), cfg.InternalDomain)
+	err = networkManager.Init(network, internalIP, lkey.Get().PrivateWG(), cfg.InternalDomain)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	dnsStopper := dns.StartServer(internalIP.String(), DNSPort, cfg.ExternalDNS, cfg.InternalDomain, appManager)
+	dnsStopper, err := dns.StartServer(internalIP.String(), DNSPort, cfg.ExternalDNS, cfg.InternalDomain, cloud.MeshNetwork, cfg.DNSHealthAware, appManager, dns.CreateRecordManager(dbcli))
+	if err != nil {
+		log.Fatal(err)
+	}
 	stoppers["dns"] = dnsStopper
 
+	// the listen addresses depend on the configured transports, so they are published every time the instance starts
+	err = cloudManager.SetInstanceMultiaddrs(m.GetInstanceName(), p2pManager.ListenAddrs())
+	if err != nil {
+		log.Errorf("Failed to publish p2p listen addresses: %s", err.Error())
+	}
+
+	// periodically rotate the local key. Peers accept both keys until the new one becomes active
+	keyRotator := sm.NewKeyRotator(cfg.WorkDir, m.GetInstanceName(), lkey, cfg.KeyRotationInterval, cfg.KeyRotationOverlap, func(newKey *pcrypto.Key, expiresAt time.Time) error {
+		// the p2p identity is switched first, so that a failure to move the listeners leaves everything on the
+		// current key
+		err := p2pManager.RotateIdentity(newKey, expiresAt)
+		if err != nil {
+			return err
+		}
+		err = cloudManager.SetInstancePublicKey(m.GetInstanceName(), newKey.PublicString())
+		if err != nil {
+			return err
+		}
+		return networkManager.SetPrivateKey(newKey.PrivateWG())
+	})
+	keyRotator.SetHold(func() (string, error) {
+		return externalDevicesHold(um, m.GetInstanceName())
+	})
+	stoppers["keyrotation"] = keyRotator.Start()
+
+	// react to the changes made by the other instances and devices
+	events, unsubscribe := p2pManager.Subscribe(p2p.EventDBHead, p2p.EventInstanceStatus)
+	go handleEvents(events, appManager, peerConfigurator)
+	stoppers["p2p"] = func() error {
+		err := p2pManager.PublishInstanceStatus(m.GetInstanceName(), cloud.ServerStateStopped)
+		if err != nil {
+			log.Errorf("Failed to publish instance status: %s", err.Error())
+		}
+		unsubscribe()
+		return p2pStopper()
+	}
+
 	log.Info("Started all servers successfully")
 	peerConfigurator.Refresh()
 	appManager.Refresh()
+	err = p2pManager.PublishInstanceStatus(m.GetInstanceName(), cloud.ServerStateRunning)
+	if err != nil {
+		log.Errorf("Failed to publish instance status: %s", err.Error())
+	}
 	wg.Wait()
 	log.Info("Shutdown completed")
 
 }
 
+// handleEvents refreshes the apps and the peers when the db or the other instances change
+func handleEvents(events <-chan *p2pproto.Event, appManager *app.Manager, peerConfigurator *PeerConfigurator) {
+	for event := range events {
+		log.Debugf("Received '%s' event from '%s'", p2p.EventType(event), event.Source)
+		if err := peerConfigurator.Refresh(); err != nil {
+			log.Errorf("Failed to refresh peers: %s", err.Error())
+		}
+		if event.GetDbHead() == nil {
+			continue
+		}
+		if err := appManager.Refresh(); err != nil {
+			log.Errorf("Failed to refresh apps: %s", err.Error())
+		}
+	}
+}
+
+// externalDevicesHold postpones the key rotations of an instance that is the hub of external devices. Their
+// WireGuard configs pin the key of the hub, so they would lose their connection once the new key is activated
+func externalDevicesHold(um *auth.UserManager, instanceName string) (string, error) {
+	admin, err := um.GetAdmin()
+	if err != nil {
+		if strings.Contains(err.Error(), "could not find admin user") {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to retrieve admin user: %w", err)
+	}
+
+	devices := []string{}
+	for _, device := range admin.GetDevices() {
+		if device.Hub == instanceName {
+			devices = append(devices, device.Name)
+		}
+	}
+	if len(devices) == 0 {
+		return "", nil
+	}
+	return fmt.Sprintf("the instance is the hub of the external devices '%s', whose WireGuard configs use its key", strings.Join(devices, "', '")), nil
+}
+
 type PeerConfigurator struct {
 	UserManager    *auth.UserManager
 	NetworkManager *network.Manager
@@ -178,18 +265,26 @@ func (pc *PeerConfigurator) Refresh() error {
 
 	peers := []p2p.Machine{}
 	for _, instance := range instances {
+		// instances that are still being deployed don't have a key yet
+		if instance.PublicKey == "" {
+			continue
+		}
 		peers = append(peers, instance)
 	}
 
 	admin, err := pc.UserManager.GetAdmin()
 	if err == nil {
 		userDevices := admin.GetDevices()
-		err = pc.NetworkManager.ConfigurePeers(instances, userDevices)
+		err = pc.NetworkManager.ConfigurePeers(instances, userDevices, pc.P2PManager)
 		if err != nil {
 			return fmt.Errorf("failed to configure network peers: %w", err)
 		}
-		for _, device := range userDevices {
-			peers = append(peers, &device)
+		for i := range userDevices {
+			// external devices don't run Protos, so they are only configured as WireGuard peers
+			if userDevices[i].IsExternal() {
+				continue
+			}
+			peers = append(peers, &userDevices[i])
 		}
 	}
 	if err != nil {
@@ -0,0 +1,41 @@
+package publicdns
+
+import (
+	"fmt"
+
+	"github.com/protosio/protos/internal/config"
+	"github.com/protosio/protos/internal/util"
+)
+
+var log = util.GetLogger("publicdns")
+
+const (
+	// ProviderRFC2136 updates the records using RFC 2136 dynamic updates, authenticated with TSIG
+	ProviderRFC2136 = "rfc2136"
+
+	defaultTTL = 300
+)
+
+// Provider manages the records of a public DNS zone
+type Provider interface {
+	Zone() string                                                 // returns the fully qualified zone managed by the provider
+	GetRecords(name string, rrtype string) ([]string, error)      // returns the values of the records of a type for a name
+	SetRecords(name string, rrtype string, values []string) error // replaces all the records of a type for a name
+	DeleteRecords(name string, rrtype string) error               // removes all the records of a type for a name
+}
+
+// NewProvider creates a public DNS provider based on the config. It returns nil if public DNS is not configured
+func NewProvider(cfg config.PublicDNSConfig) (Provider, error) {
+	switch cfg.Provider {
+	case "":
+		return nil, nil
+	case ProviderRFC2136:
+		provider, err := NewRFC2136(cfg.Zone, cfg.Server, cfg.TSIGKey, cfg.TSIGSecret, cfg.TSIGAlgorithm, cfg.TTL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create public DNS provider: %w", err)
+		}
+		return provider, nil
+	default:
+		return nil, fmt.Errorf("public DNS provider '%s' is not supported", cfg.Provider)
+	}
+}
@@ -0,0 +1,183 @@
+package publicdns
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+const (
+	updateTimeout = 5 * time.Second
+	tsigFudge     = 300
+)
+
+// RFC2136 is a provider that updates the records of a zone on an authoritative server, using dynamic updates
+type RFC2136 struct {
+	zone          string
+	server        string
+	tsigKey       string
+	tsigSecret    string
+	tsigAlgorithm string
+	ttl           uint32
+}
+
+// NewRFC2136 creates an RFC 2136 provider. The updates are signed with TSIG if a key is provided, using HMAC-SHA256
+// if no algorithm is specified
+func NewRFC2136(zone string, server string, tsigKey string, tsigSecret string, tsigAlgorithm string, ttl uint32) (*RFC2136, error) {
+	if zone == "" {
+		return nil, fmt.Errorf("zone cannot be empty")
+	}
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		return nil, fmt.Errorf("invalid DNS server '%s': %w", server, err)
+	}
+	if tsigKey != "" && tsigSecret == "" {
+		return nil, fmt.Errorf("TSIG key '%s' has no secret", tsigKey)
+	}
+
+	if tsigKey != "" {
+		tsigKey = strings.ToLower(dns.Fqdn(tsigKey))
+	}
+	if tsigAlgorithm == "" {
+		tsigAlgorithm = dns.HmacSHA256
+	}
+	if ttl == 0 {
+		ttl = defaultTTL
+	}
+
+	return &RFC2136{
+		zone:          strings.ToLower(dns.Fqdn(zone)),
+		server:        server,
+		tsigKey:       tsigKey,
+		tsigSecret:    tsigSecret,
+		tsigAlgorithm: dns.Fqdn(tsigAlgorithm),
+		ttl:           ttl,
+	}, nil
+}
+
+// Zone returns the zone managed by the provider
+func (p *RFC2136) Zone() string {
+	return p.zone
+}
+
+func (p *RFC2136) recordName(name string) (string, error) {
+	name = strings.ToLower(dns.Fqdn(name))
+	if !dns.IsSubDomain(p.zone, name) {
+		return "", fmt.Errorf("record '%s' is not part of zone '%s'", name, p.zone)
+	}
+	return name, nil
+}
+
+func (p *RFC2136) exchange(msg *dns.Msg) (*dns.Msg, error) {
+	c := &dns.Client{Net: "tcp", Timeout: updateTimeout}
+	if p.tsigKey != "" {
+		c.TsigSecret = map[string]string{p.tsigKey: p.tsigSecret}
+		msg.SetTsig(p.tsigKey, p.tsigAlgorithm, tsigFudge, time.Now().Unix())
+	}
+
+	resp, _, err := c.Exchange(msg, p.server)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request to '%s': %w", p.server, err)
+	}
+	return resp, nil
+}
+
+func (p *RFC2136) update(msg *dns.Msg) error {
+	resp, err := p.exchange(msg)
+	if err != nil {
+		return err
+	}
+	if resp.Rcode != dns.RcodeSuccess {
+		return fmt.Errorf("update rejected by '%s': %s", p.server, dns.RcodeToString[resp.Rcode])
+	}
+	return nil
+}
+
+// GetRecords queries the server for the records of a type for a name. The values use the same format as the ones
+// passed to SetRecords
+func (p *RFC2136) GetRecords(name string, rrtype string) ([]string, error) {
+	name, err := p.recordName(name)
+	if err != nil {
+		return nil, err
+	}
+	qtype, found := dns.StringToType[strings.ToUpper(rrtype)]
+	if !found {
+		return nil, fmt.Errorf("unknown record type '%s'", rrtype)
+	}
+
+	msg := &dns.Msg{}
+	msg.SetQuestion(name, qtype)
+	resp, err := p.exchange(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query %s records for '%s': %w", dns.TypeToString[qtype], name, err)
+	}
+	if resp.Rcode == dns.RcodeNameError {
+		return []string{}, nil
+	}
+	if resp.Rcode != dns.RcodeSuccess {
+		return nil, fmt.Errorf("failed to query %s records for '%s': query rejected by '%s': %s", dns.TypeToString[qtype], name, p.server, dns.RcodeToString[resp.Rcode])
+	}
+
+	values := []string{}
+	for _, rr := range resp.Answer {
+		if rr.Header().Rrtype != qtype || !strings.EqualFold(rr.Header().Name, name) {
+			continue
+		}
+		values = append(values, strings.TrimPrefix(rr.String(), rr.Header().String()))
+	}
+	return values, nil
+}
+
+// SetRecords replaces the records of a type for a name, in a single update
+func (p *RFC2136) SetRecords(name string, rrtype string, values []string) error {
+	name, err := p.recordName(name)
+	if err != nil {
+		return err
+	}
+	qtype, found := dns.StringToType[strings.ToUpper(rrtype)]
+	if !found {
+		return fmt.Errorf("unknown record type '%s'", rrtype)
+	}
+
+	rrs := []dns.RR{}
+	for _, value := range values {
+		rr, err := dns.NewRR(fmt.Sprintf("%s %d IN %s %s", name, p.ttl, dns.TypeToString[qtype], value))
+		if err != nil {
+			return fmt.Errorf("invalid %s record '%s' for '%s': %w", dns.TypeToString[qtype], value, name, err)
+		}
+		rrs = append(rrs, rr)
+	}
+
+	msg := &dns.Msg{}
+	msg.SetUpdate(p.zone)
+	msg.RemoveRRset([]dns.RR{&dns.ANY{Hdr: dns.RR_Header{Name: name, Rrtype: qtype, Class: dns.ClassANY}}})
+	msg.Insert(rrs)
+	err = p.update(msg)
+	if err != nil {
+		return fmt.Errorf("failed to set %s records for '%s': %w", dns.TypeToString[qtype], name, err)
+	}
+	return nil
+}
+
+// DeleteRecords removes the records of a type for a name
+func (p *RFC2136) DeleteRecords(name string, rrtype string) error {
+	name, err := p.recordName(name)
+	if err != nil {
+		return err
+	}
+	qtype, found := dns.StringToType[strings.ToUpper(rrtype)]
+	if !found {
+		return fmt.Errorf("unknown record type '%s'", rrtype)
+	}
+
+	msg := &dns.Msg{}
+	msg.SetUpdate(p.zone)
+	msg.RemoveRRset([]dns.RR{&dns.ANY{Hdr: dns.RR_Header{Name: name, Rrtype: qtype, Class: dns.ClassANY}}})
+	err = p.update(msg)
+	if err != nil {
+		return fmt.Errorf("failed to delete %s records for '%s': %w", dns.TypeToString[qtype], name, err)
+	}
+	return nil
+}
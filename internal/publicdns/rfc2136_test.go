@@ -0,0 +1,144 @@
+package publicdns
+
+import (
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+const (
+	testZone   = "example.org."
+	testKey    = "protos."
+	testSecret = "c2VjcmV0LXNlY3JldC1zZWNyZXQtc2VjcmV0"
+)
+
+// testServer is a minimal authoritative server that answers TSIG signed queries and applies TSIG signed dynamic
+// updates, using an in-memory zone
+type testServer struct {
+	mu      sync.Mutex
+	records map[string][]dns.RR
+}
+
+func (ts *testServer) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
+	resp := &dns.Msg{}
+	resp.SetReply(r)
+	if r.IsTsig() == nil || w.TsigStatus() != nil {
+		resp.SetRcode(r, dns.RcodeNotAuth)
+		w.WriteMsg(resp)
+		return
+	}
+
+	ts.mu.Lock()
+	if r.Opcode == dns.OpcodeQuery {
+		for _, q := range r.Question {
+			resp.Answer = append(resp.Answer, ts.records[q.Name+"/"+dns.TypeToString[q.Qtype]]...)
+		}
+	}
+	for _, rr := range r.Ns {
+		key := rr.Header().Name + "/" + dns.TypeToString[rr.Header().Rrtype]
+		if rr.Header().Class == dns.ClassANY {
+			delete(ts.records, key)
+		} else {
+			ts.records[key] = append(ts.records[key], rr)
+		}
+	}
+	ts.mu.Unlock()
+
+	resp.SetTsig(testKey, dns.HmacSHA256, tsigFudge, time.Now().Unix())
+	w.WriteMsg(resp)
+}
+
+func (ts *testServer) get(key string) []dns.RR {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return ts.records[key]
+}
+
+func TestRFC2136(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := &testServer{records: map[string][]dns.RR{}}
+	srv := &dns.Server{Listener: listener, Net: "tcp", Handler: ts, TsigSecret: map[string]string{testKey: testSecret}}
+	// the default accept function rejects updates
+	srv.MsgAcceptFunc = func(dh dns.Header) dns.MsgAcceptAction {
+		return dns.MsgAccept
+	}
+	go srv.ActivateAndServe()
+	defer srv.Shutdown()
+
+	provider, err := NewRFC2136("example.org", listener.Addr().String(), "protos", testSecret, "", 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("SetRecords", func(t *testing.T) {
+		err := provider.SetRecords("web.example.org", "A", []string{"1.2.3.4"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = provider.SetRecords("web.example.org", "A", []string{"5.6.7.8"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		records := ts.get("web.example.org./A")
+		if len(records) != 1 || !records[0].(*dns.A).A.Equal(net.ParseIP("5.6.7.8")) || records[0].Header().Ttl != 60 {
+			t.Errorf("expected the A record to be replaced, got %v", records)
+		}
+	})
+
+	t.Run("GetRecords", func(t *testing.T) {
+		values, err := provider.GetRecords("web.example.org", "A")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(values) != 1 || values[0] != "5.6.7.8" {
+			t.Errorf("expected the A record values, got %v", values)
+		}
+
+		err = provider.SetRecords("_protos-records.example.org", "TXT", []string{`"web.example.org./A"`})
+		if err != nil {
+			t.Fatal(err)
+		}
+		values, err = provider.GetRecords("_protos-records.example.org", "TXT")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(values) != 1 || values[0] != `"web.example.org./A"` {
+			t.Errorf("expected the TXT record values, got %v", values)
+		}
+	})
+
+	t.Run("DeleteRecords", func(t *testing.T) {
+		err := provider.DeleteRecords("web.example.org", "A")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if records := ts.get("web.example.org./A"); len(records) != 0 {
+			t.Errorf("expected the A record to be deleted, got %v", records)
+		}
+	})
+
+	t.Run("Outside zone", func(t *testing.T) {
+		if err := provider.SetRecords("web.example.com", "A", []string{"1.2.3.4"}); err == nil {
+			t.Error("expected records outside the zone to be rejected")
+		}
+	})
+
+	t.Run("Wrong secret", func(t *testing.T) {
+		wrongProvider, err := NewRFC2136(testZone, listener.Addr().String(), "protos", "d3Jvbmc=", "", 60)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := wrongProvider.SetRecords("web.example.org", "A", []string{"1.2.3.4"}); err == nil {
+			t.Error("expected update signed with the wrong secret to fail")
+		}
+		if records := ts.get("web.example.org./A"); len(records) != 0 {
+			t.Errorf("expected no records, got %v", records)
+		}
+	})
+}
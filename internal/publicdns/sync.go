@@ -0,0 +1,276 @@
+package publicdns
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/protosio/protos/internal/app"
+	"github.com/protosio/protos/internal/cloud"
+)
+
+const (
+	// Capability is the app capability that results in a public DNS record
+	Capability = "PublicDNS"
+
+	syncInterval = 5 * time.Minute
+
+	// registryLabel names the TXT record that lists the records set by the syncer, as <name>/<type> values. It
+	// allows the syncer to remove the records that are not needed anymore after a restart
+	registryLabel = "_protos-records"
+)
+
+type appProvider interface {
+	GetAll() ([]app.App, error)
+}
+
+type instanceProvider interface {
+	GetInstances() ([]cloud.InstanceInfo, error)
+}
+
+type recordKey struct {
+	name   string
+	rrtype string
+}
+
+// Syncer keeps the public records of the apps with the PublicDNS capability in sync with the public IP of the instance
+// they run on. Each app gets an A or AAAA record named <app>.<zone>
+type Syncer struct {
+	provider  Provider
+	apps      appProvider
+	instances instanceProvider
+	trigger   chan struct{}
+
+	mu sync.Mutex
+	// records that were set by the syncer. Only these are deleted when they are not needed anymore. The values of
+	// the records loaded from the registry are unknown, so they are set again at the first sync
+	records map[recordKey][]string
+	// registry holds the entries of the registry record, or nil if it wasn't loaded yet
+	registry []string
+}
+
+// NewSyncer creates a public DNS syncer
+func NewSyncer(provider Provider, apps appProvider, instances instanceProvider) *Syncer {
+	return &Syncer{
+		provider:  provider,
+		apps:      apps,
+		instances: instances,
+		trigger:   make(chan struct{}, 1),
+		records:   map[recordKey][]string{},
+	}
+}
+
+// desiredRecords returns the records required by the current app placement and instance IPs
+func (s *Syncer) desiredRecords() (map[recordKey][]string, error) {
+	apps, err := s.apps.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve apps: %w", err)
+	}
+	instances, err := s.instances.GetInstances()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve instances: %w", err)
+	}
+
+	instanceIPs := map[string]net.IP{}
+	for _, instance := range instances {
+		instanceIPs[instance.Name] = net.ParseIP(instance.PublicIP)
+	}
+
+	records := map[recordKey][]string{}
+	for i := range apps {
+		if !apps[i].HasCapability(Capability) {
+			continue
+		}
+		ip := instanceIPs[apps[i].InstanceName]
+		if ip == nil {
+			log.Debugf("Skipping public DNS record for app '%s': instance '%s' has no public IP", apps[i].Name, apps[i].InstanceName)
+			continue
+		}
+
+		key := recordKey{name: strings.ToLower(apps[i].Name) + "." + s.provider.Zone(), rrtype: "A"}
+		if ip.To4() == nil {
+			key.rrtype = "AAAA"
+		}
+		records[key] = append(records[key], ip.String())
+	}
+
+	for key := range records {
+		sort.Strings(records[key])
+	}
+	return records, nil
+}
+
+func (s *Syncer) registryName() string {
+	return registryLabel + "." + s.provider.Zone()
+}
+
+// loadRegistry adds the records listed in the registry record to the records managed by the syncer
+func (s *Syncer) loadRegistry() error {
+	values, err := s.provider.GetRecords(s.registryName(), "TXT")
+	if err != nil {
+		return fmt.Errorf("failed to retrieve the public DNS record registry: %w", err)
+	}
+
+	registry := []string{}
+	for _, value := range values {
+		entry := strings.Trim(value, `"`)
+		name, rrtype, found := strings.Cut(entry, "/")
+		if !found || name == "" || rrtype == "" {
+			log.Warnf("Ignoring invalid public DNS record registry entry '%s'", value)
+			continue
+		}
+		key := recordKey{name: name, rrtype: rrtype}
+		if _, found := s.records[key]; !found {
+			s.records[key] = nil
+		}
+		registry = append(registry, entry)
+	}
+	sort.Strings(registry)
+	s.registry = registry
+	return nil
+}
+
+// saveRegistry replaces the registry record with the provided records, if they changed since it was last saved
+func (s *Syncer) saveRegistry(records map[recordKey][]string) error {
+	registry := []string{}
+	for key := range records {
+		registry = append(registry, key.name+"/"+key.rrtype)
+	}
+	sort.Strings(registry)
+	if equalValues(registry, s.registry) {
+		return nil
+	}
+
+	var err error
+	if len(registry) == 0 {
+		err = s.provider.DeleteRecords(s.registryName(), "TXT")
+	} else {
+		values := []string{}
+		for _, entry := range registry {
+			values = append(values, `"`+entry+`"`)
+		}
+		err = s.provider.SetRecords(s.registryName(), "TXT", values)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update the public DNS record registry: %w", err)
+	}
+	s.registry = registry
+	return nil
+}
+
+func equalValues(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Sync updates the public records that changed since the last sync, and removes the ones that are not needed anymore
+func (s *Syncer) Sync() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	desired, err := s.desiredRecords()
+	if err != nil {
+		return fmt.Errorf("failed to sync public DNS records: %w", err)
+	}
+
+	if s.registry == nil {
+		if err := s.loadRegistry(); err != nil {
+			return fmt.Errorf("failed to sync public DNS records: %w", err)
+		}
+	}
+
+	// the new records are added to the registry before they are set, so they are still deleted if the syncer stops
+	// before the registry is updated again
+	owned := map[recordKey][]string{}
+	for key, values := range s.records {
+		owned[key] = values
+	}
+	for key, values := range desired {
+		owned[key] = values
+	}
+	if err := s.saveRegistry(owned); err != nil {
+		return fmt.Errorf("failed to sync public DNS records: %w", err)
+	}
+
+	var lastErr error
+	for key, values := range desired {
+		if current, found := s.records[key]; found && equalValues(current, values) {
+			continue
+		}
+		err := s.provider.SetRecords(key.name, key.rrtype, values)
+		if err != nil {
+			log.Errorf("Failed to set public DNS record: %s", err.Error())
+			lastErr = err
+			continue
+		}
+		log.Infof("Public DNS record '%s' (%s) set to '%s'", key.name, key.rrtype, strings.Join(values, ", "))
+		s.records[key] = values
+	}
+
+	for key := range s.records {
+		if _, found := desired[key]; found {
+			continue
+		}
+		err := s.provider.DeleteRecords(key.name, key.rrtype)
+		if err != nil {
+			log.Errorf("Failed to delete public DNS record: %s", err.Error())
+			lastErr = err
+			continue
+		}
+		log.Infof("Public DNS record '%s' (%s) deleted", key.name, key.rrtype)
+		delete(s.records, key)
+	}
+
+	if err := s.saveRegistry(s.records); err != nil {
+		log.Error(err.Error())
+		lastErr = err
+	}
+
+	if lastErr != nil {
+		return fmt.Errorf("failed to sync public DNS records: %w", lastErr)
+	}
+	return nil
+}
+
+// Trigger schedules a sync, without waiting for it to finish
+func (s *Syncer) Trigger() {
+	select {
+	case s.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// Start syncs the records periodically and every time a sync is triggered. It returns a function that stops it
+func (s *Syncer) Start() func() error {
+	stop := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(syncInterval)
+		defer ticker.Stop()
+		for {
+			if err := s.Sync(); err != nil {
+				log.Error(err.Error())
+			}
+			select {
+			case <-ticker.C:
+			case <-s.trigger:
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	return func() error {
+		close(stop)
+		return nil
+	}
+}
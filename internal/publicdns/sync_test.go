@@ -0,0 +1,132 @@
+package publicdns
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/protosio/protos/internal/app"
+	"github.com/protosio/protos/internal/cloud"
+)
+
+// fakeProvider keeps the records of the zone in memory
+type fakeProvider struct {
+	records map[recordKey][]string
+	fail    bool
+}
+
+func (p *fakeProvider) Zone() string {
+	return testZone
+}
+
+func (p *fakeProvider) GetRecords(name string, rrtype string) ([]string, error) {
+	if p.fail {
+		return nil, fmt.Errorf("provider failure")
+	}
+	return p.records[recordKey{name: name, rrtype: rrtype}], nil
+}
+
+func (p *fakeProvider) SetRecords(name string, rrtype string, values []string) error {
+	if p.fail {
+		return fmt.Errorf("provider failure")
+	}
+	p.records[recordKey{name: name, rrtype: rrtype}] = values
+	return nil
+}
+
+func (p *fakeProvider) DeleteRecords(name string, rrtype string) error {
+	if p.fail {
+		return fmt.Errorf("provider failure")
+	}
+	delete(p.records, recordKey{name: name, rrtype: rrtype})
+	return nil
+}
+
+type fakeApps []app.App
+
+func (a *fakeApps) GetAll() ([]app.App, error) {
+	return *a, nil
+}
+
+type fakeInstances []cloud.InstanceInfo
+
+func (i *fakeInstances) GetInstances() ([]cloud.InstanceInfo, error) {
+	return *i, nil
+}
+
+func checkRecord(t *testing.T, provider *fakeProvider, name string, rrtype string, expected ...string) {
+	t.Helper()
+	values := provider.records[recordKey{name: name, rrtype: rrtype}]
+	if !equalValues(values, expected) {
+		t.Errorf("expected %s record '%s' to be %v, got %v", rrtype, name, expected, values)
+	}
+}
+
+func TestSyncer(t *testing.T) {
+	provider := &fakeProvider{records: map[recordKey][]string{
+		{name: "manual.example.org.", rrtype: "A"}: {"9.9.9.9"},
+	}}
+	apps := &fakeApps{
+		{Name: "Web", InstanceName: "one", Capabilities: []string{Capability}},
+		{Name: "mail", InstanceName: "two", Capabilities: []string{Capability}},
+		{Name: "internal", InstanceName: "one"},
+	}
+	instances := &fakeInstances{
+		{Name: "one", PublicIP: "1.2.3.4"},
+		{Name: "two", PublicIP: "2001:db8::1"},
+	}
+	syncer := NewSyncer(provider, apps, instances)
+
+	t.Run("Set records", func(t *testing.T) {
+		if err := syncer.Sync(); err != nil {
+			t.Fatal(err)
+		}
+		checkRecord(t, provider, "web.example.org.", "A", "1.2.3.4")
+		checkRecord(t, provider, "mail.example.org.", "AAAA", "2001:db8::1")
+		checkRecord(t, provider, "internal.example.org.", "A")
+		checkRecord(t, provider, "manual.example.org.", "A", "9.9.9.9")
+		checkRecord(t, provider, "_protos-records.example.org.", "TXT", `"mail.example.org./AAAA"`, `"web.example.org./A"`)
+	})
+
+	t.Run("Update records", func(t *testing.T) {
+		(*instances)[0].PublicIP = "5.6.7.8"
+		if err := syncer.Sync(); err != nil {
+			t.Fatal(err)
+		}
+		checkRecord(t, provider, "web.example.org.", "A", "5.6.7.8")
+	})
+
+	t.Run("Delete records", func(t *testing.T) {
+		*apps = (*apps)[:1]
+		if err := syncer.Sync(); err != nil {
+			t.Fatal(err)
+		}
+		checkRecord(t, provider, "mail.example.org.", "AAAA")
+		checkRecord(t, provider, "manual.example.org.", "A", "9.9.9.9")
+		checkRecord(t, provider, "_protos-records.example.org.", "TXT", `"web.example.org./A"`)
+	})
+
+	t.Run("Delete records after restart", func(t *testing.T) {
+		*apps = fakeApps{}
+		restarted := NewSyncer(provider, apps, instances)
+		if err := restarted.Sync(); err != nil {
+			t.Fatal(err)
+		}
+		checkRecord(t, provider, "web.example.org.", "A")
+		checkRecord(t, provider, "manual.example.org.", "A", "9.9.9.9")
+		checkRecord(t, provider, "_protos-records.example.org.", "TXT")
+	})
+
+	t.Run("Registry not available", func(t *testing.T) {
+		*apps = fakeApps{{Name: "web", InstanceName: "one", Capabilities: []string{Capability}}}
+		provider.fail = true
+		restarted := NewSyncer(provider, apps, instances)
+		if err := restarted.Sync(); err == nil {
+			t.Fatal("expected the sync to fail when the registry can't be loaded")
+		}
+		provider.fail = false
+		if err := restarted.Sync(); err != nil {
+			t.Fatal(err)
+		}
+		checkRecord(t, provider, "web.example.org.", "A", "5.6.7.8")
+	})
+}
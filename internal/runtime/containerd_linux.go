@@ -308,6 +308,14 @@ func (cdp *containerdPlatform) getOrCreateVolume(id string) error {
 	return nil
 }
 
+// GetVolumePath returns the path of the data volume of a sandbox
+func (cdp *containerdPlatform) GetVolumePath(id string) (string, error) {
+	if !cdp.volumeExists(id) {
+		return "", fmt.Errorf("could not find data volume for sandbox '%s'", id)
+	}
+	return cdp.volumesPath + "/" + id, nil
+}
+
 func (cdp *containerdPlatform) volumeExists(id string) bool {
 	volumePath := cdp.volumesPath + "/" + id
 	info, err := os.Stat(volumePath)
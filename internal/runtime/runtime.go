@@ -50,6 +50,7 @@ type RuntimePlatform interface {
 	PullImage(imageRef string) error
 	RemoveImage(id string) error
 	NewSandbox(name string, appID string, imageID string, persistence bool) (RuntimeSandbox, error)
+	GetVolumePath(appID string) (string, error)
 	GetHWStats() (HardwareStats, error)
 }
 
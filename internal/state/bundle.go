@@ -0,0 +1,237 @@
+// Package state defines the bundle used for exporting the state of a Protos account, and restoring it on a new
+// device. The bundle is a JSON document that is signed by the key of the exporting device, and the state it holds
+// can be encrypted using a passphrase
+package state
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+)
+
+// BundleVersion is the version of the bundle format. Bundles with a newer version are refused
+const BundleVersion = 1
+
+const (
+	kdfScrypt   = "scrypt"
+	scryptN     = 1 << 15
+	scryptR     = 8
+	scryptP     = 1
+	keySize     = 32
+	saltSize    = 16
+	nonceSize   = 24
+	signContext = "protos-state"
+)
+
+// Bundle is the exported document. When the state is encrypted, State holds the ciphertext
+type Bundle struct {
+	Version    int         `json:"version"`
+	PublicKey  []byte      `json:"public_key"` // ed25519 key of the device that exported the state
+	Signature  []byte      `json:"signature"`  // signature of the version and the state, created using the device key
+	Encryption *Encryption `json:"encryption,omitempty"`
+	State      []byte      `json:"state"`
+}
+
+// Encryption describes how the key that encrypts the state is derived from the passphrase
+type Encryption struct {
+	KDF  string `json:"kdf"`
+	Salt []byte `json:"salt"`
+	N    int    `json:"n"`
+	R    int    `json:"r"`
+	P    int    `json:"p"`
+}
+
+// State holds everything needed to rebuild the db of a device and reconnect to the instances
+type State struct {
+	ProtosVersion  string          `json:"protos_version"`
+	CreatedAt      time.Time       `json:"created_at"`
+	DeviceKey      []byte          `json:"device_key"` // seed of the ed25519 key of the exporting device
+	DataKey        []byte          `json:"data_key"`   // key that encrypts the secrets in the db
+	Users          []User          `json:"users"`
+	Instances      []Instance      `json:"instances"`
+	CloudProviders []CloudProvider `json:"cloud_providers"`
+	Apps           []App           `json:"apps"`
+	DNSRecords     []DNSRecord     `json:"dns_records"`
+}
+
+type User struct {
+	Username string   `json:"username"`
+	Name     string   `json:"name"`
+	IsAdmin  bool     `json:"is_admin"`
+	Devices  []Device `json:"devices"`
+}
+
+type Device struct {
+	MachineID string `json:"machine_id"`
+	Name      string `json:"name"`
+	PublicKey string `json:"public_key"`
+	Network   string `json:"network"`
+	Hub       string `json:"hub,omitempty"`
+
+	ApprovedKey string `json:"approved_key,omitempty"`
+	ApprovedBy  string `json:"approved_by,omitempty"`
+	Approval    string `json:"approval,omitempty"`
+}
+
+type Instance struct {
+	VMID          string   `json:"vm_id"`
+	Name          string   `json:"name"`
+	SSHKeySeed    string   `json:"ssh_key_seed"`
+	PublicKey     string   `json:"public_key"`
+	PublicIP      string   `json:"public_ip"`
+	Multiaddrs    []string `json:"multiaddrs"`
+	InternalIP    string   `json:"internal_ip"`
+	CloudType     string   `json:"cloud_type"`
+	CloudName     string   `json:"cloud_name"`
+	Location      string   `json:"location"`
+	Network       string   `json:"network"`
+	ProtosVersion string   `json:"protos_version"`
+	Architecture  string   `json:"architecture"`
+}
+
+type CloudProvider struct {
+	Name string            `json:"name"`
+	Type string            `json:"type"`
+	Auth map[string]string `json:"auth"`
+}
+
+type App struct {
+	ID            string   `json:"id"`
+	Name          string   `json:"name"`
+	InstallerRef  string   `json:"installer_ref"`
+	InstanceName  string   `json:"instance_name"`
+	DesiredStatus string   `json:"desired_status"`
+	IP            string   `json:"ip"`
+	Persistence   bool     `json:"persistence"`
+	Ports         []string `json:"ports"`
+	Capabilities  []string `json:"capabilities"`
+}
+
+type DNSRecord struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// signedMessage returns the content that is signed by the device key
+func signedMessage(version int, state []byte) []byte {
+	return append([]byte(fmt.Sprintf("%s:%d:", signContext, version)), state...)
+}
+
+// deriveKey derives the encryption key from a passphrase. The parameters are not covered by the signature, so only
+// the ones used by Seal are accepted, which prevents a modified bundle from making the derivation arbitrarily expensive
+func deriveKey(passphrase string, enc *Encryption) (*[keySize]byte, error) {
+	if enc.N != scryptN || enc.R != scryptR || enc.P != scryptP {
+		return nil, fmt.Errorf("unsupported key derivation parameters N=%d, r=%d, p=%d", enc.N, enc.R, enc.P)
+	}
+	derived, err := scrypt.Key([]byte(passphrase), enc.Salt, enc.N, enc.R, enc.P, keySize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key from passphrase: %w", err)
+	}
+	key := [keySize]byte(derived)
+	return &key, nil
+}
+
+// Seal creates a bundle from the state, signed using the key of the exporting device. If a passphrase is provided,
+// the state is encrypted using a key derived from it
+func Seal(st State, passphrase string) ([]byte, error) {
+	if len(st.DeviceKey) != ed25519.SeedSize {
+		return nil, fmt.Errorf("invalid device key")
+	}
+	privateKey := ed25519.NewKeyFromSeed(st.DeviceKey)
+
+	stateJSON, err := json.Marshal(st)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode state: %w", err)
+	}
+
+	bundle := Bundle{
+		Version:   BundleVersion,
+		PublicKey: privateKey.Public().(ed25519.PublicKey),
+		State:     stateJSON,
+	}
+
+	if passphrase != "" {
+		bundle.Encryption = &Encryption{KDF: kdfScrypt, Salt: make([]byte, saltSize), N: scryptN, R: scryptR, P: scryptP}
+		if _, err := rand.Read(bundle.Encryption.Salt); err != nil {
+			return nil, fmt.Errorf("failed to generate salt: %w", err)
+		}
+		key, err := deriveKey(passphrase, bundle.Encryption)
+		if err != nil {
+			return nil, err
+		}
+		var nonce [nonceSize]byte
+		if _, err := rand.Read(nonce[:]); err != nil {
+			return nil, fmt.Errorf("failed to generate nonce: %w", err)
+		}
+		bundle.State = secretbox.Seal(nonce[:], stateJSON, &nonce, key)
+	}
+
+	bundle.Signature = ed25519.Sign(privateKey, signedMessage(bundle.Version, bundle.State))
+	return json.MarshalIndent(bundle, "", "  ")
+}
+
+// IsEncrypted checks if the state in a bundle is encrypted, in which case a passphrase is required to open it
+func IsEncrypted(data []byte) (bool, error) {
+	bundle := Bundle{}
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return false, fmt.Errorf("failed to decode state bundle: %w", err)
+	}
+	return bundle.Encryption != nil, nil
+}
+
+// Open verifies the signature of a bundle and returns the state it holds. The state has to contain the key that
+// signed the bundle
+func Open(data []byte, passphrase string) (State, error) {
+	st := State{}
+	bundle := Bundle{}
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return st, fmt.Errorf("failed to decode state bundle: %w", err)
+	}
+	if bundle.Version > BundleVersion {
+		return st, fmt.Errorf("state bundle version %d is newer than the supported version %d: protos needs to be upgraded", bundle.Version, BundleVersion)
+	}
+	if len(bundle.PublicKey) != ed25519.PublicKeySize || !ed25519.Verify(bundle.PublicKey, signedMessage(bundle.Version, bundle.State), bundle.Signature) {
+		return st, fmt.Errorf("state bundle has an invalid signature")
+	}
+
+	stateJSON := bundle.State
+	if bundle.Encryption != nil {
+		if passphrase == "" {
+			return st, fmt.Errorf("state bundle is encrypted and requires a passphrase")
+		}
+		if bundle.Encryption.KDF != kdfScrypt {
+			return st, fmt.Errorf("state bundle uses unsupported key derivation '%s'", bundle.Encryption.KDF)
+		}
+		key, err := deriveKey(passphrase, bundle.Encryption)
+		if err != nil {
+			return st, err
+		}
+		if len(bundle.State) < nonceSize+secretbox.Overhead {
+			return st, fmt.Errorf("state bundle is truncated")
+		}
+		nonce := [nonceSize]byte(bundle.State[:nonceSize])
+		var ok bool
+		stateJSON, ok = secretbox.Open(nil, bundle.State[nonceSize:], &nonce, key)
+		if !ok {
+			return st, fmt.Errorf("failed to decrypt state bundle: wrong passphrase")
+		}
+	}
+
+	if err := json.Unmarshal(stateJSON, &st); err != nil {
+		return st, fmt.Errorf("failed to decode state: %w", err)
+	}
+	if len(st.DeviceKey) != ed25519.SeedSize {
+		return st, fmt.Errorf("state bundle has an invalid device key")
+	}
+	publicKey := ed25519.NewKeyFromSeed(st.DeviceKey).Public().(ed25519.PublicKey)
+	if !publicKey.Equal(ed25519.PublicKey(bundle.PublicKey)) {
+		return st, fmt.Errorf("state bundle was not signed by the device key it contains")
+	}
+	return st, nil
+}
@@ -0,0 +1,76 @@
+package state
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"testing"
+)
+
+func TestBundle(t *testing.T) {
+	seed := make([]byte, ed25519.SeedSize)
+	if _, err := rand.Read(seed); err != nil {
+		t.Fatal(err)
+	}
+	st := State{
+		DeviceKey: seed,
+		Users:     []User{{Username: "admin", IsAdmin: true}},
+		Instances: []Instance{{Name: "instance1", SSHKeySeed: "secret"}},
+	}
+
+	for _, passphrase := range []string{"", "passphrase"} {
+		data, err := Seal(st, passphrase)
+		if err != nil {
+			t.Fatal(err)
+		}
+		encrypted, err := IsEncrypted(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if encrypted != (passphrase != "") {
+			t.Errorf("bundle encryption is %t for passphrase '%s'", encrypted, passphrase)
+		}
+
+		opened, err := Open(data, passphrase)
+		if err != nil {
+			t.Fatalf("failed to open bundle: %s", err.Error())
+		}
+		if len(opened.Instances) != 1 || opened.Instances[0].SSHKeySeed != "secret" {
+			t.Errorf("opened state is different from the sealed one: %+v", opened)
+		}
+
+		bundle := Bundle{}
+		if err := json.Unmarshal(data, &bundle); err != nil {
+			t.Fatal(err)
+		}
+		bundle.State[len(bundle.State)-1] ^= 1
+		tampered, err := json.Marshal(bundle)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := Open(tampered, passphrase); err == nil {
+			t.Error("tampered bundle should be refused")
+		}
+	}
+
+	data, err := Seal(st, "passphrase")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Open(data, "wrong"); err == nil {
+		t.Error("bundle should not be opened using the wrong passphrase")
+	}
+
+	bundle := Bundle{}
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		t.Fatal(err)
+	}
+	bundle.Encryption.N = 1 << 30
+	expensive, err := json.Marshal(bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Open(expensive, "passphrase"); err == nil {
+		t.Error("bundle with different key derivation parameters should be refused")
+	}
+}
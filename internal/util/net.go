@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net"
 	"strconv"
+	"strings"
 )
 
 // PortType defines a port type, that can hold TCP or UDP
@@ -18,18 +19,55 @@ var UDP = PortType("UDP")
 // SCTP port type
 var SCTP = PortType("SCTP")
 
-//Port defines a struct that holds information about a port
+//Port defines a struct that holds information about a port. The optional name identifies the service that is
+// available on the port
 type Port struct {
+	Name string
 	Nr   int
 	Type PortType
 }
 
 // MarshalJSON is a customer JSON marshallers for Port
 func (port *Port) MarshalJSON() ([]byte, error) {
-	portStr := fmt.Sprintf("\"%s/%s\"", strconv.Itoa(port.Nr), string(port.Type))
+	portStr := fmt.Sprintf("\"%s\"", port.String())
 	return []byte(portStr), nil
 }
 
+// String returns the port in the [name:]number/type format
+func (port Port) String() string {
+	if port.Name != "" {
+		return fmt.Sprintf("%s:%d/%s", port.Name, port.Nr, string(port.Type))
+	}
+	return fmt.Sprintf("%d/%s", port.Nr, string(port.Type))
+}
+
+// ParsePort parses a port in the [name:]number[/type] format. The type defaults to TCP
+func ParsePort(portStr string) (Port, error) {
+	port := Port{Type: TCP}
+	value := portStr
+	if name, rest, found := strings.Cut(value, ":"); found {
+		if name == "" || len(name) > 15 || strings.Trim(strings.ToLower(name), "abcdefghijklmnopqrstuvwxyz0123456789-") != "" {
+			return Port{}, fmt.Errorf("invalid name '%s' for port '%s'", name, portStr)
+		}
+		port.Name = strings.ToLower(name)
+		value = rest
+	}
+	if nr, portType, found := strings.Cut(value, "/"); found {
+		port.Type = PortType(strings.ToUpper(portType))
+		value = nr
+	}
+	if port.Type != TCP && port.Type != UDP {
+		return Port{}, fmt.Errorf("invalid type '%s' for port '%s'. Only TCP and UDP are supported", string(port.Type), portStr)
+	}
+
+	nr, err := strconv.Atoi(value)
+	if err != nil || nr < 1 || nr > 65535 {
+		return Port{}, fmt.Errorf("invalid port number in '%s'", portStr)
+	}
+	port.Nr = nr
+	return port, nil
+}
+
 // GetLocalIPs returns the locally configured IP address
 func GetLocalIPs() ([]string, error) {
 	ips := []string{}